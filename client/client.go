@@ -0,0 +1,261 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gallactic/gallactic/common/binary"
+	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/execution"
+	"github.com/gallactic/gallactic/core/validator"
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/txs"
+	"github.com/gallactic/gallactic/txs/tx"
+)
+
+// Config of the client
+type Config struct {
+	// APIKey is sent to the node if the authentication is enabled
+	APIKey string
+	// Retries is the number of the retries after a temporary failure, like a connection error or a rate limit.
+	// The broadcasts are never retried, the node may have received the transaction before the failure.
+	Retries int
+	// RetryDelay is the delay before the first retry. It is doubled on each retry.
+	RetryDelay time.Duration
+	// Timeout of each call. Zero means no timeout other than the context of the call.
+	Timeout time.Duration
+	// TLS config for `https` JSON-RPC addresses and for gRPC. gRPC connections are insecure if it is nil.
+	TLS *tls.Config
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		Retries:    3,
+		RetryDelay: 500 * time.Millisecond,
+		Timeout:    2 * time.Minute,
+	}
+}
+
+// Block is a committed block with the decoded transactions
+type Block struct {
+	Height          int64
+	Hash            binary.HexBytes
+	Time            time.Time
+	ChainID         string
+	AppHash         binary.HexBytes
+	ProposerAddress crypto.Address
+	Txs             []*txs.Envelope
+}
+
+// transport calls the node over JSON-RPC or gRPC
+type transport interface {
+	chainID(ctx context.Context) (string, error)
+	account(ctx context.Context, addr crypto.Address) (*account.Account, error)
+	mempoolAccount(ctx context.Context, addr crypto.Address) (*account.Account, error)
+	validator(ctx context.Context, addr crypto.Address) (*validator.Validator, error)
+	storageAt(ctx context.Context, addr crypto.Address, key binary.HexBytes) (binary.HexBytes, error)
+	block(ctx context.Context, height uint64) (*Block, error)
+	broadcastTxSync(ctx context.Context, env *txs.Envelope) (*txs.Receipt, error)
+	broadcastTxAsync(ctx context.Context, env *txs.Envelope) (*txs.Receipt, error)
+	broadcastTxCommit(ctx context.Context, env *txs.Envelope) (*execution.TxCommitResult, error)
+	broadcastTxAuto(ctx context.Context, t tx.Tx) (*txs.Receipt, error)
+	// temporary returns true if the call can be retried after the error
+	temporary(err error) bool
+	close() error
+}
+
+// Client is a typed client of a Gallactic node. It is safe for concurrent use.
+type Client struct {
+	transport transport
+	config    Config
+	lk        sync.Mutex
+	chainID   string
+}
+
+func newClient(t transport, conf *Config) *Client {
+	if conf == nil {
+		conf = DefaultConfig()
+	}
+	return &Client{transport: t, config: *conf}
+}
+
+// Close closes the connections to the node
+func (c *Client) Close() error {
+	return c.transport.close()
+}
+
+// call calls the node and retries the temporary failures until the retries are exhausted or the context is done
+func (c *Client) call(ctx context.Context, fn func(ctx context.Context) error) error {
+	delay := c.config.RetryDelay
+	for retry := 0; ; retry++ {
+		err := c.callOnce(ctx, fn)
+		if err == nil || retry >= c.config.Retries || ctx.Err() != nil || !c.transport.temporary(err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+func (c *Client) callOnce(ctx context.Context, fn func(ctx context.Context) error) error {
+	if c.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.config.Timeout)
+		defer cancel()
+	}
+	return fn(ctx)
+}
+
+// ChainID returns the chain ID of the node. It is fetched once.
+func (c *Client) ChainID(ctx context.Context) (string, error) {
+	c.lk.Lock()
+	chainID := c.chainID
+	c.lk.Unlock()
+	if chainID != "" {
+		return chainID, nil
+	}
+
+	err := c.call(ctx, func(ctx context.Context) (err error) {
+		chainID, err = c.transport.chainID(ctx)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	c.lk.Lock()
+	c.chainID = chainID
+	c.lk.Unlock()
+	return chainID, nil
+}
+
+func (c *Client) GetAccount(ctx context.Context, addr crypto.Address) (acc *account.Account, err error) {
+	err = c.call(ctx, func(ctx context.Context) error {
+		acc, err = c.transport.account(ctx, addr)
+		return err
+	})
+	return acc, err
+}
+
+// GetMempoolAccount returns the account with the changes of the transactions in the mempool,
+// like the pending balance and sequence
+func (c *Client) GetMempoolAccount(ctx context.Context, addr crypto.Address) (acc *account.Account, err error) {
+	err = c.call(ctx, func(ctx context.Context) error {
+		acc, err = c.transport.mempoolAccount(ctx, addr)
+		return err
+	})
+	return acc, err
+}
+
+func (c *Client) GetValidator(ctx context.Context, addr crypto.Address) (val *validator.Validator, err error) {
+	err = c.call(ctx, func(ctx context.Context) error {
+		val, err = c.transport.validator(ctx, addr)
+		return err
+	})
+	return val, err
+}
+
+// GetStorageAt returns the value of the key in the storage of the contract. The value is empty if the key is not set.
+func (c *Client) GetStorageAt(ctx context.Context, addr crypto.Address, key binary.HexBytes) (value binary.HexBytes, err error) {
+	err = c.call(ctx, func(ctx context.Context) error {
+		value, err = c.transport.storageAt(ctx, addr, key)
+		return err
+	})
+	return value, err
+}
+
+// GetBlock returns the block at the height. Zero height means the latest block.
+func (c *Client) GetBlock(ctx context.Context, height uint64) (block *Block, err error) {
+	err = c.call(ctx, func(ctx context.Context) error {
+		block, err = c.transport.block(ctx, height)
+		return err
+	})
+	return block, err
+}
+
+// Sequence returns the sequence of the account or the validator with this address.
+// The next transaction of the signer should have the next sequence.
+func (c *Client) Sequence(ctx context.Context, addr crypto.Address) (uint64, error) {
+	if addr.IsValidatorAddress() {
+		val, err := c.GetValidator(ctx, addr)
+		if err != nil {
+			return 0, err
+		}
+		return val.Sequence(), nil
+	}
+	acc, err := c.GetAccount(ctx, addr)
+	if err != nil {
+		return 0, err
+	}
+	return acc.Sequence(), nil
+}
+
+// BroadcastTxSync broadcasts the signed transaction and waits until it is executed
+func (c *Client) BroadcastTxSync(ctx context.Context, env *txs.Envelope) (receipt *txs.Receipt, err error) {
+	if err := checkSigned(env); err != nil {
+		return nil, err
+	}
+	err = c.callOnce(ctx, func(ctx context.Context) error {
+		receipt, err = c.transport.broadcastTxSync(ctx, env)
+		return err
+	})
+	return receipt, err
+}
+
+// BroadcastTxAsync broadcasts the signed transaction and returns once it is added to the mempool
+func (c *Client) BroadcastTxAsync(ctx context.Context, env *txs.Envelope) (receipt *txs.Receipt, err error) {
+	if err := checkSigned(env); err != nil {
+		return nil, err
+	}
+	err = c.callOnce(ctx, func(ctx context.Context) error {
+		receipt, err = c.transport.broadcastTxAsync(ctx, env)
+		return err
+	})
+	return receipt, err
+}
+
+// BroadcastTxCommit broadcasts the signed transaction and waits until the block which includes it is committed.
+// The mempool rejection and the timeout of the node are returned as distinct errors: JSON-RPC errors with
+// the rpc.RPCErrorTxRejected and rpc.RPCErrorTxTimeout codes, or gRPC FailedPrecondition and DeadlineExceeded.
+func (c *Client) BroadcastTxCommit(ctx context.Context, env *txs.Envelope) (result *execution.TxCommitResult, err error) {
+	if err := checkSigned(env); err != nil {
+		return nil, err
+	}
+	err = c.callOnce(ctx, func(ctx context.Context) error {
+		result, err = c.transport.broadcastTxCommit(ctx, env)
+		return err
+	})
+	return result, err
+}
+
+// BroadcastTxAuto asks the node to set the sequence of the transaction and to sign it with the node-managed key
+// of the signer. It returns once the transaction is added to the mempool. The node would submit a retried
+// transaction again with a new sequence.
+func (c *Client) BroadcastTxAuto(ctx context.Context, t tx.Tx) (receipt *txs.Receipt, err error) {
+	err = c.callOnce(ctx, func(ctx context.Context) error {
+		receipt, err = c.transport.broadcastTxAuto(ctx, t)
+		return err
+	})
+	return receipt, err
+}
+
+func checkSigned(env *txs.Envelope) error {
+	if env == nil || len(env.Signatories) == 0 {
+		return errors.New("Transaction is not signed")
+	}
+	return nil
+}
+
+func noBlockError(height uint64) error {
+	if height == 0 {
+		return errors.New("There is no block yet")
+	}
+	return fmt.Errorf("There is no block at height %d", height)
+}
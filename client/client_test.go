@@ -0,0 +1,458 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gallactic/gallactic/common/binary"
+	"github.com/gallactic/gallactic/core"
+	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/account/permission"
+	"github.com/gallactic/gallactic/core/config"
+	"github.com/gallactic/gallactic/core/proposal"
+	"github.com/gallactic/gallactic/core/validator"
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/keystore/key"
+	"github.com/gallactic/gallactic/rpc"
+	"github.com/gallactic/gallactic/rpc/auth"
+	"github.com/gallactic/gallactic/txs"
+	"github.com/gallactic/gallactic/txs/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var tGenesis *proposal.Genesis
+var tSigners []crypto.Signer
+var tValidator crypto.Signer
+var tJSONRPCAddress string
+var tGRPCAddress string
+
+// tAPIKey has the admin level, the node-managed keys need the authentication
+const tAPIKey = "client-test-key"
+
+func freePort() int {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+// startNode boots a node with a single validator in this process
+func startNode(dir string) (*core.Kernel, error) {
+	// The 4th and the 5th accounts are managed by the node
+	keysDir := filepath.Join(dir, "keys")
+	accs := make([]*account.Account, 7)
+	for i := range accs {
+		k := key.GenAccountKey()
+		tSigners = append(tSigners, crypto.NewAccountSigner(k.PrivateKey()))
+		accs[i], _ = account.NewAccount(k.Address())
+		accs[i].AddToBalance(1000000)
+		if i == 3 || i == 4 {
+			if err := key.EncryptKeyFile(k, filepath.Join(keysDir, k.Address().String()+".json"), "secret", ""); err != nil {
+				return nil, err
+			}
+		}
+	}
+	valKey := key.GenValidatorKey()
+	tValidator = crypto.NewValidatorSigner(valKey.PrivateKey())
+	val, _ := validator.NewValidator(valKey.PublicKey(), 0)
+	gAcc, _ := account.NewAccount(crypto.GlobalAddress)
+	gAcc.SetPermissions(permission.AllPermissions)
+	tGenesis = proposal.MakeGenesis("client-test", time.Now().Truncate(0).UTC(), gAcc, accs, nil, []*validator.Validator{val})
+
+	conf := config.DefaultConfig()
+	conf.Tendermint.SetRoot(dir)
+	conf.Tendermint.P2P.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", freePort())
+	conf.Tendermint.RPC.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", freePort())
+	conf.Tendermint.Consensus.TimeoutCommit = 100 * time.Millisecond
+	conf.RPC.Server.Bind.Address = "127.0.0.1"
+	conf.RPC.Server.Bind.Port = uint16(freePort())
+	conf.GRPC.ListenAddress = fmt.Sprintf("127.0.0.1:%d", freePort())
+	conf.GRPC.HTTPAddress = fmt.Sprintf("127.0.0.1:%d", freePort())
+	conf.Logging.Level = "error"
+	conf.Logging.Modules = nil
+	conf.Logging.File.Enabled = false
+	conf.Keys.Enabled = true
+	conf.Keys.Dir = keysDir
+	conf.Keys.Passphrase = "secret"
+	conf.RPC.Admin = true
+	conf.Auth.Enabled = true
+	conf.Auth.APIKeys = []auth.APIKey{{Key: tAPIKey, Level: "admin"}}
+	conf.Mempool.MinFee = 1
+	tJSONRPCAddress = fmt.Sprintf("http://127.0.0.1:%d%s", conf.RPC.Server.Bind.Port, conf.RPC.Server.HTTP.JsonRpcEndpoint)
+	tGRPCAddress = conf.GRPC.ListenAddress
+
+	kernel, err := core.NewKernel(context.Background(), tGenesis, conf, tValidator, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := kernel.Boot(); err != nil {
+		return nil, err
+	}
+	return kernel, nil
+}
+
+func TestMain(m *testing.M) {
+	dir, err := ioutil.TempDir("", "client-test")
+	if err != nil {
+		panic(err)
+	}
+	kernel, err := startNode(dir)
+	if err != nil {
+		panic(err)
+	}
+
+	// Wait for the first block
+	c := NewJSONRPCClient(tJSONRPCAddress, nil)
+	for i := 0; i < 100; i++ {
+		if _, err = c.GetBlock(context.Background(), 1); err == nil {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	exitCode := m.Run()
+
+	kernel.Shutdown(context.Background())
+	os.RemoveAll(dir)
+	os.Exit(exitCode)
+}
+
+func testClients(t *testing.T) map[string]*Client {
+	conf := DefaultConfig()
+	conf.APIKey = tAPIKey
+	grpcClient, err := NewGRPCClient(tGRPCAddress, conf)
+	require.NoError(t, err)
+	return map[string]*Client{
+		"jsonrpc": NewJSONRPCClient(tJSONRPCAddress, conf),
+		"grpc":    grpcClient,
+	}
+}
+
+func TestQueries(t *testing.T) {
+	ctx := context.Background()
+	for name, c := range testClients(t) {
+		t.Run(name, func(t *testing.T) {
+			defer c.Close()
+
+			chainID, err := c.ChainID(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tGenesis.ChainID(), chainID)
+
+			genAcc := tGenesis.Accounts()[1]
+			acc, err := c.GetAccount(ctx, genAcc.Address())
+			require.NoError(t, err)
+			assert.Equal(t, genAcc.Address(), acc.Address())
+			assert.Equal(t, genAcc.Balance(), acc.Balance())
+			assert.Equal(t, genAcc.Sequence(), acc.Sequence())
+
+			val, err := c.GetValidator(ctx, tValidator.Address())
+			require.NoError(t, err)
+			assert.Equal(t, tGenesis.Validators()[0], val)
+
+			seq, err := c.Sequence(ctx, tValidator.Address())
+			require.NoError(t, err)
+			assert.Equal(t, uint64(0), seq)
+
+			_, err = c.GetAccount(ctx, crypto.GlobalAddress)
+			require.NoError(t, err)
+			_, err = c.GetAccount(ctx, key.GenAccountKey().Address())
+			assert.Error(t, err)
+
+			value, err := c.GetStorageAt(ctx, tSigners[0].Address(), []byte{1})
+			require.NoError(t, err)
+			assert.Empty(t, value)
+
+			block, err := c.GetBlock(ctx, 1)
+			require.NoError(t, err)
+			assert.Equal(t, int64(1), block.Height)
+			assert.Equal(t, chainID, block.ChainID)
+			assert.Equal(t, tValidator.Address(), block.ProposerAddress)
+
+			latest, err := c.GetBlock(ctx, 0)
+			require.NoError(t, err)
+			assert.True(t, latest.Height >= 1)
+
+			_, err = c.GetBlock(ctx, 1000000)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestBroadcast(t *testing.T) {
+	ctx := context.Background()
+	i := 0
+	for name, c := range testClients(t) {
+		signer := tSigners[i]
+		receiver := tSigners[2].Address()
+		i++
+		t.Run(name, func(t *testing.T) {
+			defer c.Close()
+			builder := c.NewTxBuilder(signer)
+
+			env, err := builder.Send(ctx, receiver, 100, 1)
+			require.NoError(t, err)
+			receipt, err := c.BroadcastTxSync(ctx, env)
+			require.NoError(t, err)
+			assert.Equal(t, txs.Ok, receipt.Status)
+			assert.Equal(t, binary.HexBytes(env.Hash()), receipt.Hash)
+
+			// The receipt is sent before the block is committed. The next transaction is
+			// committed after it, so waiting for the next one commits both.
+			next, err := tx.NewSendTx(signer.Address(), receiver, 2, 100, 1)
+			require.NoError(t, err)
+			nextEnv, err := builder.Sign(ctx, next)
+			require.NoError(t, err)
+			_, err = c.BroadcastTxCommit(ctx, nextEnv)
+			require.NoError(t, err)
+			seq, err := c.Sequence(ctx, signer.Address())
+			require.NoError(t, err)
+			assert.Equal(t, uint64(2), seq)
+
+			block, err := c.GetBlock(ctx, uint64(receipt.Height))
+			require.NoError(t, err)
+			var hashes []binary.HexBytes
+			for _, tx := range block.Txs {
+				hashes = append(hashes, tx.Hash())
+			}
+			assert.Contains(t, hashes, binary.HexBytes(env.Hash()))
+
+			env, err = builder.Send(ctx, receiver, 100, 1)
+			require.NoError(t, err)
+			_, err = c.BroadcastTxAsync(ctx, env)
+			require.NoError(t, err)
+			// The mempool account has the changes of the transaction, even if it is not committed yet
+			pending, err := c.GetMempoolAccount(ctx, signer.Address())
+			require.NoError(t, err)
+			assert.Equal(t, uint64(3), pending.Sequence())
+			assert.Equal(t, uint64(1000000-3*101), pending.Balance())
+			// The same sequence is used again
+			_, err = c.BroadcastTxAsync(ctx, env)
+			assert.Error(t, err)
+
+			// Not signed
+			_, err = c.BroadcastTxSync(ctx, txs.Enclose(env.ChainID, env.Tx))
+			assert.Error(t, err)
+
+			// Expired, the chain is already passed the first block
+			env, err = builder.ValidUntil(1).Send(ctx, receiver, 100, 1)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(1), env.ValidUntilHeight)
+			_, err = c.BroadcastTxSync(ctx, env)
+			assert.Error(t, err)
+
+			// The fee is lower than the node's minimum fee
+			env, err = builder.ValidUntil(0).Send(ctx, receiver, 100, 0)
+			require.NoError(t, err)
+			_, err = c.BroadcastTxSync(ctx, env)
+			require.Error(t, err)
+			if name == "jsonrpc" {
+				rpcErr, ok := err.(*rpc.RPCError)
+				require.True(t, ok)
+				assert.Equal(t, rpc.RPCErrorLowFee, rpcErr.Code)
+			} else {
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestBroadcastCommit(t *testing.T) {
+	ctx := context.Background()
+	i := 5
+	for name, c := range testClients(t) {
+		signer := tSigners[i]
+		receiver := tSigners[2].Address()
+		i++
+		t.Run(name, func(t *testing.T) {
+			defer c.Close()
+			builder := c.NewTxBuilder(signer)
+
+			env, err := builder.Send(ctx, receiver, 100, 1)
+			require.NoError(t, err)
+			result, err := c.BroadcastTxCommit(ctx, env)
+			require.NoError(t, err)
+			assert.Equal(t, binary.HexBytes(env.Hash()), result.CheckTx.Hash)
+			assert.Equal(t, binary.HexBytes(env.Hash()), result.DeliverTx.Hash)
+			assert.Equal(t, txs.Ok, result.DeliverTx.Status)
+			assert.Equal(t, result.Height, result.DeliverTx.Height)
+
+			// The block is committed
+			block, err := c.GetBlock(ctx, uint64(result.Height))
+			require.NoError(t, err)
+			assert.Equal(t, block.Hash, result.BlockHash)
+			seq, err := c.Sequence(ctx, signer.Address())
+			require.NoError(t, err)
+			assert.Equal(t, uint64(1), seq)
+
+			// The sequence is used, so the mempool rejects it
+			_, err = c.BroadcastTxCommit(ctx, env)
+			require.Error(t, err)
+			if name == "jsonrpc" {
+				rpcErr, ok := err.(*rpc.RPCError)
+				require.True(t, ok)
+				assert.Equal(t, rpc.RPCErrorTxRejected, rpcErr.Code)
+			} else {
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestBroadcastAuto(t *testing.T) {
+	ctx := context.Background()
+	i := 3
+	for name, c := range testClients(t) {
+		signer := tSigners[i]
+		receiver := tSigners[2].Address()
+		i++
+		t.Run(name, func(t *testing.T) {
+			defer c.Close()
+
+			// The submissions of the signer are queued and sequenced by the node
+			receipts := make([]*txs.Receipt, 5)
+			errs := make([]error, 5)
+			var wg sync.WaitGroup
+			for j := range receipts {
+				wg.Add(1)
+				go func(j int) {
+					defer wg.Done()
+					sendTx, err := tx.NewSendTx(signer.Address(), receiver, 0, 100, 1)
+					if err != nil {
+						errs[j] = err
+						return
+					}
+					receipts[j], errs[j] = c.BroadcastTxAuto(ctx, sendTx)
+				}(j)
+			}
+			wg.Wait()
+			for j := range receipts {
+				require.NoError(t, errs[j])
+				assert.NotEmpty(t, receipts[j].Hash)
+			}
+
+			var seq uint64
+			for k := 0; k < 100 && seq < 5; k++ {
+				time.Sleep(100 * time.Millisecond)
+				seq, _ = c.Sequence(ctx, signer.Address())
+			}
+			assert.Equal(t, uint64(5), seq)
+
+			// The node has no key for this signer
+			sendTx, err := tx.NewSendTx(tSigners[0].Address(), receiver, 0, 100, 1)
+			require.NoError(t, err)
+			_, err = c.BroadcastTxAuto(ctx, sendTx)
+			assert.Error(t, err)
+
+			// The callers without the admin level can't sign with the node-managed keys
+			sendTx, err = tx.NewSendTx(signer.Address(), receiver, 0, 100, 1)
+			require.NoError(t, err)
+			_, err = NewJSONRPCClient(tJSONRPCAddress, nil).BroadcastTxAuto(ctx, sendTx)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestContextCancellation(t *testing.T) {
+	for name, c := range testClients(t) {
+		t.Run(name, func(t *testing.T) {
+			defer c.Close()
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err := c.GetAccount(ctx, tSigners[0].Address())
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRetries(t *testing.T) {
+	var calls int32
+	// The handler is swapped while the server is serving
+	var lk sync.Mutex
+	var handler http.HandlerFunc
+	setHandler := func(h http.HandlerFunc) {
+		lk.Lock()
+		defer lk.Unlock()
+		handler = h
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lk.Lock()
+		h := handler
+		lk.Unlock()
+		h(w, r)
+	}))
+	defer server.Close()
+
+	setHandler(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.Write([]byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":"1","error":{"code":%d,"message":"Too many requests"}}`,
+				rpc.RPCErrorLimitExceeded)))
+		default:
+			w.Write([]byte(`{"jsonrpc":"2.0","id":"1","result":{"ChainId":"test-chain"}}`))
+		}
+	})
+
+	conf := DefaultConfig()
+	conf.RetryDelay = time.Millisecond
+	c := NewJSONRPCClient(server.URL, conf)
+	chainID, err := c.ChainID(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "test-chain", chainID)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+
+	// No retries
+	atomic.StoreInt32(&calls, 0)
+	conf.Retries = 0
+	c = NewJSONRPCClient(server.URL, conf)
+	_, err = c.ChainID(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// Invalid request is not retried
+	setHandler(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Write([]byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":"1","error":{"code":%d,"message":"Invalid params"}}`,
+			rpc.RPCErrorInvalidParams)))
+	})
+	atomic.StoreInt32(&calls, 0)
+	c = NewJSONRPCClient(server.URL, DefaultConfig())
+	_, err = c.ChainID(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// Broadcasts are not retried, the node may have received the transaction
+	setHandler(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	sendTx, err := tx.NewSendTx(tSigners[0].Address(), tSigners[1].Address(), 1, 100, 1)
+	require.NoError(t, err)
+	env := txs.Enclose("test-chain", sendTx)
+	require.NoError(t, env.Sign(tSigners[0]))
+	atomic.StoreInt32(&calls, 0)
+	_, err = c.BroadcastTxSync(context.Background(), env)
+	assert.Error(t, err)
+	_, err = c.BroadcastTxCommit(context.Background(), env)
+	assert.Error(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gallactic/gallactic/common/binary"
+	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/execution"
+	"github.com/gallactic/gallactic/core/validator"
+	"github.com/gallactic/gallactic/crypto"
+	pb "github.com/gallactic/gallactic/rpc/grpc/proto3"
+	"github.com/gallactic/gallactic/txs"
+	"github.com/gallactic/gallactic/txs/tx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type grpcTransport struct {
+	conn        *grpc.ClientConn
+	apiKey      string
+	blockchain  pb.BlockChainClient
+	transaction pb.TransactionClient
+}
+
+// NewGRPCClient creates a client which calls the gRPC server of the node, like `localhost:50051`.
+// The connection is established in the background.
+func NewGRPCClient(address string, conf *Config) (*Client, error) {
+	c := newClient(nil, conf)
+	opt := grpc.WithInsecure()
+	if c.config.TLS != nil {
+		opt = grpc.WithTransportCredentials(credentials.NewTLS(c.config.TLS))
+	}
+	conn, err := grpc.Dial(address, opt)
+	if err != nil {
+		return nil, err
+	}
+	c.transport = &grpcTransport{
+		conn:        conn,
+		apiKey:      c.config.APIKey,
+		blockchain:  pb.NewBlockChainClient(conn),
+		transaction: pb.NewTransactionClient(conn),
+	}
+	return c, nil
+}
+
+// outgoing adds the API key to the metadata of the call
+func (t *grpcTransport) outgoing(ctx context.Context) context.Context {
+	if t.apiKey == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, "x-api-key", t.apiKey)
+}
+
+func (t *grpcTransport) temporary(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+func (t *grpcTransport) close() error {
+	return t.conn.Close()
+}
+
+func (t *grpcTransport) chainID(ctx context.Context) (string, error) {
+	res, err := t.blockchain.GetChainID(t.outgoing(ctx), &pb.Empty{})
+	if err != nil {
+		return "", err
+	}
+	return res.ChainId, nil
+}
+
+func (t *grpcTransport) account(ctx context.Context, addr crypto.Address) (*account.Account, error) {
+	res, err := t.blockchain.GetAccount(t.outgoing(ctx), &pb.AddressRequest{Address: addr.String()})
+	if err != nil {
+		return nil, err
+	}
+	if res.Account == nil {
+		return nil, status.Errorf(codes.NotFound, "There is no account with this address %s", addr)
+	}
+	return res.Account, nil
+}
+
+func (t *grpcTransport) mempoolAccount(ctx context.Context, addr crypto.Address) (*account.Account, error) {
+	res, err := t.blockchain.GetMempoolAccount(t.outgoing(ctx), &pb.AddressRequest{Address: addr.String()})
+	if err != nil {
+		return nil, err
+	}
+	if res.Account == nil {
+		return nil, status.Errorf(codes.NotFound, "There is no account with this address %s", addr)
+	}
+	return res.Account, nil
+}
+
+func (t *grpcTransport) validator(ctx context.Context, addr crypto.Address) (*validator.Validator, error) {
+	res, err := t.blockchain.GetValidator(t.outgoing(ctx), &pb.AddressRequest{Address: addr.String()})
+	if err != nil {
+		return nil, err
+	}
+	if res.Validator == nil {
+		return nil, status.Errorf(codes.NotFound, "There is no validator with this address %s", addr)
+	}
+	return toValidator(res.Validator)
+}
+
+func (t *grpcTransport) storageAt(ctx context.Context, addr crypto.Address, key binary.HexBytes) (binary.HexBytes, error) {
+	res, err := t.blockchain.GetStorageAt(t.outgoing(ctx), &pb.StorageAtRequest{Address: addr.String(), Key: key})
+	if err != nil {
+		return nil, err
+	}
+	return res.Value, nil
+}
+
+func (t *grpcTransport) block(ctx context.Context, height uint64) (*Block, error) {
+	res, err := t.blockchain.GetBlock(t.outgoing(ctx), &pb.BlockRequest{Height: height})
+	if err != nil {
+		return nil, err
+	}
+	if res.Block == nil {
+		return nil, noBlockError(height)
+	}
+
+	header := res.Block.Header
+	proposer, err := crypto.AddressFromString(header.ProposerAddress)
+	if err != nil {
+		return nil, err
+	}
+	block := &Block{
+		Height:          header.Height,
+		Hash:            header.BlockHash,
+		Time:            header.Time,
+		ChainID:         header.ChainID,
+		AppHash:         header.AppHash,
+		ProposerAddress: proposer,
+	}
+	for _, tx := range res.Block.Txs {
+		env := new(txs.Envelope)
+		if err := json.Unmarshal([]byte(tx.Envelope), env); err != nil {
+			return nil, err
+		}
+		block.Txs = append(block.Txs, env)
+	}
+	return block, nil
+}
+
+func (t *grpcTransport) broadcastTxSync(ctx context.Context, env *txs.Envelope) (*txs.Receipt, error) {
+	res, err := t.transaction.BroadcastTxSync(t.outgoing(ctx), &pb.TransactRequest{TxEnvelope: env})
+	if err != nil {
+		return nil, err
+	}
+	return res.TxReceipt, nil
+}
+
+func (t *grpcTransport) broadcastTxAsync(ctx context.Context, env *txs.Envelope) (*txs.Receipt, error) {
+	res, err := t.transaction.BroadcastTxAsync(t.outgoing(ctx), &pb.TransactRequest{TxEnvelope: env})
+	if err != nil {
+		return nil, err
+	}
+	return res.TxReceipt, nil
+}
+
+func (t *grpcTransport) broadcastTxCommit(ctx context.Context, env *txs.Envelope) (*execution.TxCommitResult, error) {
+	res, err := t.transaction.BroadcastTxCommit(t.outgoing(ctx), &pb.TransactRequest{TxEnvelope: env})
+	if err != nil {
+		return nil, err
+	}
+	return &execution.TxCommitResult{
+		CheckTx:   res.CheckTx,
+		DeliverTx: res.DeliverTx,
+		Height:    res.Height,
+		BlockHash: res.BlockHash,
+	}, nil
+}
+
+func (t *grpcTransport) broadcastTxAuto(ctx context.Context, tx tx.Tx) (*txs.Receipt, error) {
+	res, err := t.transaction.BroadcastTxAuto(t.outgoing(ctx), &pb.TransactRequest{TxEnvelope: txs.Enclose("", tx)})
+	if err != nil {
+		return nil, err
+	}
+	return res.TxReceipt, nil
+}
+
+// toValidator decodes the validator from the gRPC response
+func toValidator(info *pb.ValidatorInfo) (*validator.Validator, error) {
+	pubKey, err := crypto.PublicKeyFromString(info.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	bs, err := json.Marshal(struct {
+		PublicKey     crypto.PublicKey `json:"publicKey"`
+		Stake         uint64           `json:"stake"`
+		BondingHeight uint64           `json:"bondingHeight"`
+		Sequence      uint64           `json:"sequence"`
+	}{pubKey, info.Stake, info.BondingHeight, info.Sequence})
+	if err != nil {
+		return nil, err
+	}
+	return validator.ValidatorFromJSON(bs)
+}
@@ -0,0 +1,237 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gallactic/gallactic/common"
+	"github.com/gallactic/gallactic/common/binary"
+	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/execution"
+	"github.com/gallactic/gallactic/core/validator"
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/rpc"
+	"github.com/gallactic/gallactic/txs"
+	"github.com/gallactic/gallactic/txs/tx"
+)
+
+type jsonRPCTransport struct {
+	address    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// temporaryError is a failure which the call can be retried after
+type temporaryError struct {
+	err error
+}
+
+func (e *temporaryError) Error() string {
+	return e.err.Error()
+}
+
+// NewJSONRPCClient creates a client which calls the JSON-RPC endpoint of the node,
+// like `http://localhost:1337/rpc`
+func NewJSONRPCClient(address string, conf *Config) *Client {
+	c := newClient(nil, conf)
+	httpClient := &http.Client{}
+	if c.config.TLS != nil {
+		httpClient.Transport = &http.Transport{TLSClientConfig: c.config.TLS}
+	}
+	c.transport = &jsonRPCTransport{
+		address:    address,
+		apiKey:     c.config.APIKey,
+		httpClient: httpClient,
+	}
+	return c
+}
+
+// call calls a JSON-RPC method of the node and decodes the result
+func (t *jsonRPCTransport) call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	var rawParams json.RawMessage
+	if params != nil {
+		bs, err := json.Marshal(params)
+		if err != nil {
+			return err
+		}
+		rawParams = bs
+	}
+	body, err := json.Marshal(rpc.NewRPCRequest(common.RandomHex(8), method, rawParams))
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequest("POST", t.address, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq = httpReq.WithContext(ctx)
+	httpReq.Header.Set("Content-Type", "application/json")
+	if t.apiKey != "" {
+		httpReq.Header.Set("X-API-Key", t.apiKey)
+	}
+
+	resp, err := t.httpClient.Do(httpReq)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return &temporaryError{fmt.Errorf("Unable to connect to the node: %v", err)}
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		return fmt.Errorf("Invalid API key")
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return &temporaryError{fmt.Errorf("Node is not available: %s", resp.Status)}
+	}
+
+	var res struct {
+		Result json.RawMessage `json:"result"`
+		Error  *rpc.RPCError   `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return fmt.Errorf("Invalid response from the node: %v", err)
+	}
+	if res.Error != nil {
+		if res.Error.Code == rpc.RPCErrorLimitExceeded {
+			return &temporaryError{res.Error}
+		}
+		return res.Error
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(res.Result, result)
+}
+
+func (t *jsonRPCTransport) temporary(err error) bool {
+	_, ok := err.(*temporaryError)
+	return ok
+}
+
+func (t *jsonRPCTransport) close() error {
+	return nil
+}
+
+func (t *jsonRPCTransport) chainID(ctx context.Context) (string, error) {
+	out := new(rpc.ChainIdOutput)
+	if err := t.call(ctx, rpc.GET_CHAIN_ID, nil, out); err != nil {
+		return "", err
+	}
+	return out.ChainId, nil
+}
+
+func (t *jsonRPCTransport) account(ctx context.Context, addr crypto.Address) (*account.Account, error) {
+	out := new(rpc.AccountOutput)
+	if err := t.call(ctx, rpc.GET_ACCOUNT, rpc.AddressInput{Address: addr}, out); err != nil {
+		return nil, err
+	}
+	if out.Account == nil {
+		return nil, fmt.Errorf("There is no account with this address %s", addr)
+	}
+	return out.Account, nil
+}
+
+func (t *jsonRPCTransport) mempoolAccount(ctx context.Context, addr crypto.Address) (*account.Account, error) {
+	out := new(rpc.AccountOutput)
+	if err := t.call(ctx, rpc.GET_MEMPOOL_ACCOUNT, rpc.AddressInput{Address: addr}, out); err != nil {
+		return nil, err
+	}
+	if out.Account == nil {
+		return nil, fmt.Errorf("There is no account with this address %s", addr)
+	}
+	return out.Account, nil
+}
+
+func (t *jsonRPCTransport) validator(ctx context.Context, addr crypto.Address) (*validator.Validator, error) {
+	out := new(rpc.ValidatorOutput)
+	if err := t.call(ctx, rpc.GET_VALIDATOR, rpc.AddressInput{Address: addr}, out); err != nil {
+		return nil, err
+	}
+	if out.Validator == nil {
+		return nil, fmt.Errorf("There is no validator with this address %s", addr)
+	}
+	return out.Validator, nil
+}
+
+func (t *jsonRPCTransport) storageAt(ctx context.Context, addr crypto.Address, key binary.HexBytes) (binary.HexBytes, error) {
+	out := new(rpc.StorageOutput)
+	if err := t.call(ctx, rpc.GET_STORAGE_AT, rpc.StorageAtInput{Address: addr, Key: key}, out); err != nil {
+		return nil, err
+	}
+	return out.Value, nil
+}
+
+func (t *jsonRPCTransport) block(ctx context.Context, height uint64) (*Block, error) {
+	out := new(rpc.BlockOutput)
+	var err error
+	if height == 0 {
+		err = t.call(ctx, rpc.GET_LATEST_BLOCK, nil, out)
+	} else {
+		err = t.call(ctx, rpc.GET_BLOCK, rpc.BlockInput{Height: height}, out)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if out.Block == nil || out.Block.Block == nil || out.BlockMeta == nil || out.BlockMeta.BlockMeta == nil {
+		return nil, noBlockError(height)
+	}
+
+	header := out.BlockMeta.Header
+	proposer, err := crypto.ValidatorAddress(header.ProposerAddress)
+	if err != nil {
+		return nil, err
+	}
+	block := &Block{
+		Height:          header.Height,
+		Hash:            out.BlockMeta.BlockID.Hash.Bytes(),
+		Time:            header.Time,
+		ChainID:         header.ChainID,
+		AppHash:         header.AppHash.Bytes(),
+		ProposerAddress: proposer,
+	}
+	for _, bs := range out.Block.Data.Txs {
+		env := new(txs.Envelope)
+		if err := env.Decode(bs); err != nil {
+			return nil, err
+		}
+		block.Txs = append(block.Txs, env)
+	}
+	return block, nil
+}
+
+func (t *jsonRPCTransport) broadcastTxSync(ctx context.Context, env *txs.Envelope) (*txs.Receipt, error) {
+	receipt := new(txs.Receipt)
+	if err := t.call(ctx, rpc.BROADCAST_TX, env, receipt); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+func (t *jsonRPCTransport) broadcastTxAsync(ctx context.Context, env *txs.Envelope) (*txs.Receipt, error) {
+	receipt := new(txs.Receipt)
+	if err := t.call(ctx, rpc.BROADCAST_TX_ASYNC, env, receipt); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+func (t *jsonRPCTransport) broadcastTxCommit(ctx context.Context, env *txs.Envelope) (*execution.TxCommitResult, error) {
+	result := new(execution.TxCommitResult)
+	if err := t.call(ctx, rpc.BROADCAST_TX_COMMIT, env, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (t *jsonRPCTransport) broadcastTxAuto(ctx context.Context, tx tx.Tx) (*txs.Receipt, error) {
+	receipt := new(txs.Receipt)
+	if err := t.call(ctx, rpc.BROADCAST_TX_AUTO, txs.Enclose("", tx), receipt); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+
+	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/txs"
+	"github.com/gallactic/gallactic/txs/tx"
+)
+
+// TxBuilder builds the transactions of the signer. The sequence and the chain ID
+// are fetched from the node and the transactions are signed by the signer.
+type TxBuilder struct {
+	client     *Client
+	signer     crypto.Signer
+	validUntil uint64
+}
+
+// NewTxBuilder creates a builder for the transactions of the signer. The signer is an account signer,
+// or a validator signer for the transactions which are signed by validators, like unbond.
+func (c *Client) NewTxBuilder(signer crypto.Signer) *TxBuilder {
+	return &TxBuilder{client: c, signer: signer}
+}
+
+// ValidUntil sets the last block height that the built transactions can be included in.
+// Zero, the default, means the transactions never expire.
+func (b *TxBuilder) ValidUntil(height uint64) *TxBuilder {
+	b.validUntil = height
+	return b
+}
+
+// Build makes the transaction with the next sequence of the signer and signs it
+func (b *TxBuilder) Build(ctx context.Context, makeTx func(seq uint64) (tx.Tx, error)) (*txs.Envelope, error) {
+	seq, err := b.client.Sequence(ctx, b.signer.Address())
+	if err != nil {
+		return nil, err
+	}
+	t, err := makeTx(seq + 1)
+	if err != nil {
+		return nil, err
+	}
+	return b.Sign(ctx, t)
+}
+
+// Sign signs the transaction as it is
+func (b *TxBuilder) Sign(ctx context.Context, t tx.Tx) (*txs.Envelope, error) {
+	chainID, err := b.client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	env := txs.Enclose(chainID, t)
+	env.ValidUntilHeight = b.validUntil
+	if err := env.Sign(b.signer); err != nil {
+		return nil, err
+	}
+	return env, nil
+}
+
+func (b *TxBuilder) Send(ctx context.Context, to crypto.Address, amount, fee uint64) (*txs.Envelope, error) {
+	return b.Build(ctx, func(seq uint64) (tx.Tx, error) {
+		return tx.NewSendTx(b.signer.Address(), to, seq, amount, fee)
+	})
+}
+
+// Call calls the contract, or creates a contract if the callee is empty
+func (b *TxBuilder) Call(ctx context.Context, callee crypto.Address, data []byte, gasLimit, amount, fee uint64) (*txs.Envelope, error) {
+	return b.Build(ctx, func(seq uint64) (tx.Tx, error) {
+		return tx.NewCallTx(b.signer.Address(), callee, seq, data, gasLimit, amount, fee)
+	})
+}
+
+func (b *TxBuilder) Bond(ctx context.Context, to crypto.PublicKey, amount, fee uint64) (*txs.Envelope, error) {
+	return b.Build(ctx, func(seq uint64) (tx.Tx, error) {
+		return tx.NewBondTx(b.signer.Address(), to, amount, seq, fee)
+	})
+}
+
+// Unbond should be built by a validator signer
+func (b *TxBuilder) Unbond(ctx context.Context, to crypto.Address, amount, fee uint64) (*txs.Envelope, error) {
+	return b.Build(ctx, func(seq uint64) (tx.Tx, error) {
+		return tx.NewUnbondTx(b.signer.Address(), to, amount, seq, fee)
+	})
+}
+
+func (b *TxBuilder) Permissions(ctx context.Context, modified crypto.Address, perm account.Permissions, set bool, fee uint64) (*txs.Envelope, error) {
+	return b.Build(ctx, func(seq uint64) (tx.Tx, error) {
+		return tx.NewPermissionsTx(b.signer.Address(), modified, perm, set, seq, fee)
+	})
+}
@@ -0,0 +1,212 @@
+package genesis
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/gallactic/gallactic/cmd"
+	"github.com/gallactic/gallactic/core/proposal"
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/jawher/mow.cli"
+)
+
+// AddAccount adds a new account to the genesis file
+func AddAccount() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		address := c.String(cli.StringArg{
+			Name: "ADDRESS",
+			Desc: "Address of the account",
+		})
+		balance := c.String(cli.StringOpt{
+			Name: "balance",
+			Desc: "Balance of the account",
+		})
+		perms := c.String(cli.StringOpt{
+			Name: "perm",
+			Desc: "Permissions in hex format, e.g. 0x0002 for Send permission",
+		})
+		workingDir := addWorkingDirOption(c)
+
+		c.Spec = "ADDRESS [--balance=<balance>] [--perm=<permissions>] " + genesisSpec
+		c.Action = func() {
+			addr, err := crypto.AddressFromString(*address)
+			if err != nil {
+				cmd.PrintErrorMsg("%v", err)
+				return
+			}
+			bal, err := parseAmount("balance", *balance)
+			if err != nil {
+				cmd.PrintErrorMsg("%v", err)
+				return
+			}
+			perm, err := parsePermissions(*perms)
+			if err != nil {
+				cmd.PrintErrorMsg("%v", err)
+				return
+			}
+			editGenesis(*workingDir, func(gen *proposal.Genesis) error {
+				return gen.AddAccount(addr, bal, perm)
+			})
+		}
+	}
+}
+
+// AddValidator adds a new validator to the genesis file
+func AddValidator() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		publicKey := c.String(cli.StringArg{
+			Name: "PUBLIC_KEY",
+			Desc: "Public key of the validator",
+		})
+		stake := c.String(cli.StringOpt{
+			Name: "stake",
+			Desc: "Stake of the validator",
+		})
+		workingDir := addWorkingDirOption(c)
+
+		c.Spec = "PUBLIC_KEY [--stake=<stake>] " + genesisSpec
+		c.Action = func() {
+			pubKey, err := crypto.PublicKeyFromString(*publicKey)
+			if err != nil {
+				cmd.PrintErrorMsg("%v", err)
+				return
+			}
+			stk, err := parseAmount("stake", *stake)
+			if err != nil {
+				cmd.PrintErrorMsg("%v", err)
+				return
+			}
+			editGenesis(*workingDir, func(gen *proposal.Genesis) error {
+				return gen.AddValidator(pubKey, stk)
+			})
+		}
+	}
+}
+
+// AddContract adds a new contract to the genesis file
+func AddContract() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		address := c.String(cli.StringArg{
+			Name: "ADDRESS",
+			Desc: "Address of the contract",
+		})
+		code := c.String(cli.StringOpt{
+			Name: "c code",
+			Desc: "Hex encoded byte code of the contract",
+		})
+		codeFile := c.String(cli.StringOpt{
+			Name: "f file",
+			Desc: "Path to the file contains hex encoded byte code of the contract",
+		})
+		perms := c.String(cli.StringOpt{
+			Name: "perm",
+			Desc: "Permissions in hex format, e.g. 0x0002 for Send permission",
+		})
+		workingDir := addWorkingDirOption(c)
+
+		c.Spec = "ADDRESS (-c=<byte code> | -f=<byte code file>) [--perm=<permissions>] " + genesisSpec
+		c.Action = func() {
+			addr, err := crypto.AddressFromString(*address)
+			if err != nil {
+				cmd.PrintErrorMsg("%v", err)
+				return
+			}
+			hexCode := *code
+			if *codeFile != "" {
+				bs, err := ioutil.ReadFile(*codeFile)
+				if err != nil {
+					cmd.PrintErrorMsg("Failed to read the file: %v", err)
+					return
+				}
+				hexCode = string(bs)
+			}
+			byteCode, err := decodeHex(hexCode)
+			if err != nil {
+				cmd.PrintErrorMsg("Invalid byte code: %v", err)
+				return
+			}
+			if len(byteCode) == 0 {
+				cmd.PrintErrorMsg("Byte code is empty")
+				return
+			}
+			perm, err := parsePermissions(*perms)
+			if err != nil {
+				cmd.PrintErrorMsg("%v", err)
+				return
+			}
+			editGenesis(*workingDir, func(gen *proposal.Genesis) error {
+				return gen.AddContract(addr, byteCode, perm)
+			})
+		}
+	}
+}
+
+// SetGlobal sets the balance, permissions or code of the global account.
+// The options which are not set, keep their current value.
+func SetGlobal() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		balance := c.String(cli.StringOpt{
+			Name: "balance",
+			Desc: "Balance of the global account",
+		})
+		perms := c.String(cli.StringOpt{
+			Name: "perm",
+			Desc: "Global permissions in hex format, e.g. 0x01ff for all permissions",
+		})
+		code := c.String(cli.StringOpt{
+			Name: "c code",
+			Desc: "Hex encoded byte code of the global account",
+		})
+		workingDir := addWorkingDirOption(c)
+
+		c.Spec = "[--balance=<balance>] [--perm=<permissions>] [-c=<byte code>] " + genesisSpec
+		c.Action = func() {
+			editGenesis(*workingDir, func(gen *proposal.Genesis) error {
+				gAcc := gen.GlobalAccount()
+				bal := gAcc.Balance()
+				perm := gAcc.Permissions()
+				byteCode := gAcc.Code()
+				var err error
+				if *balance != "" {
+					if bal, err = parseAmount("balance", *balance); err != nil {
+						return err
+					}
+				}
+				if *perms != "" {
+					if perm, err = parsePermissions(*perms); err != nil {
+						return err
+					}
+				}
+				if *code != "" {
+					if byteCode, err = decodeHex(*code); err != nil {
+						return fmt.Errorf("Invalid byte code: %v", err)
+					}
+				}
+				return gen.SetGlobalAccount(bal, perm, byteCode)
+			})
+		}
+	}
+}
+
+// Validate checks the genesis file
+func Validate() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		workingDir := addWorkingDirOption(c)
+
+		c.Spec = genesisSpec
+		c.Action = func() {
+			file := genesisFile(*workingDir)
+			gen, err := proposal.LoadFromFile(file)
+			if err != nil {
+				cmd.PrintErrorMsg("Could not obtain genesis. %v", err)
+				cli.Exit(1)
+			}
+			if err := gen.Validate(); err != nil {
+				cmd.PrintErrorMsg("Genesis is invalid: %v", err)
+				cli.Exit(1)
+			}
+			cmd.PrintSuccessMsg("Genesis is valid")
+			cmd.PrintInfoMsg("Chain ID: %v", gen.ChainID())
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package genesis
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gallactic/gallactic/cmd"
+	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/proposal"
+	"github.com/jawher/mow.cli"
+)
+
+// genesisSpec is the common part of the spec for all the genesis commands
+const genesisSpec = "[-w=<working directory>]"
+
+func addWorkingDirOption(c *cli.Cmd) *string {
+	return c.String(cli.StringOpt{
+		Name:  "w working-dir",
+		Desc:  "Working directory of the genesis file",
+		Value: ".",
+	})
+}
+
+func genesisFile(workingDir string) string {
+	path, _ := filepath.Abs(workingDir)
+	return path + "/genesis.json"
+}
+
+// editGenesis loads the genesis file, applies the modification and saves the genesis file in place
+func editGenesis(workingDir string, edit func(gen *proposal.Genesis) error) {
+	file := genesisFile(workingDir)
+	gen, err := proposal.LoadFromFile(file)
+	if err != nil {
+		cmd.PrintErrorMsg("Could not obtain genesis. %v", err)
+		return
+	}
+	if err := edit(gen); err != nil {
+		cmd.PrintErrorMsg("%v", err)
+		return
+	}
+	if err := gen.SaveToFile(file); err != nil {
+		cmd.PrintErrorMsg("Failed to write genesis file: %v", err)
+		return
+	}
+	cmd.PrintSuccessMsg("Genesis file is updated: %v", file)
+}
+
+func parsePermissions(perms string) (account.Permissions, error) {
+	var perm account.Permissions
+	if perms == "" {
+		return perm, nil
+	}
+	if err := perm.UnmarshalText([]byte(perms)); err != nil {
+		return perm, fmt.Errorf("Invalid permissions: %v", err)
+	}
+	return perm, nil
+}
+
+// parseAmount parses the amount as uint64. Genesis balances can exceed the range of int.
+func parseAmount(name string, amount string) (uint64, error) {
+	if amount == "" {
+		return 0, nil
+	}
+	amt, err := strconv.ParseUint(amount, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid %s: %v", name, err)
+	}
+	return amt, nil
+}
+
+func decodeHex(str string) ([]byte, error) {
+	str = strings.TrimSpace(str)
+	str = strings.TrimPrefix(str, "0x")
+	return hex.DecodeString(str)
+}
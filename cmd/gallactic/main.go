@@ -3,7 +3,10 @@ package main
 import (
 	"os"
 
+	"github.com/gallactic/gallactic/cmd/gallactic/genesis"
 	"github.com/gallactic/gallactic/cmd/gallactic/key"
+	"github.com/gallactic/gallactic/cmd/gallactic/query"
+	"github.com/gallactic/gallactic/cmd/gallactic/tx"
 	"github.com/jawher/mow.cli"
 )
 
@@ -20,7 +23,15 @@ func gallactic() *cli.Cli {
 	app := cli.App("gallactic", "Gallactic blockchain node")
 
 	app.Command("init", "Initialize the gallactic blockchain", Init())
+	app.Command("testnet", "Initialize a local testnet with multiple nodes", Testnet())
 	app.Command("start", "Start the gallactic blockchain", Start())
+	app.Command("genesis", "Edit and validate the genesis file", func(k *cli.Cmd) {
+		k.Command("add-account", "Add an account to the genesis", genesis.AddAccount())
+		k.Command("add-validator", "Add a validator to the genesis", genesis.AddValidator())
+		k.Command("add-contract", "Add a contract to the genesis", genesis.AddContract())
+		k.Command("set-global", "Set the global account of the genesis", genesis.SetGlobal())
+		k.Command("validate", "Validate the genesis", genesis.Validate())
+	})
 	app.Command("key", "Create gallactic key file for signing messages", func(k *cli.Cmd) {
 		k.Command("generate", "Generate a new key", key.Generate())
 		k.Command("inspect", "Inspect a key file", key.Inspect())
@@ -28,6 +39,25 @@ func gallactic() *cli.Cli {
 		k.Command("verify", "Verify a signature", key.Verify())
 		k.Command("change-auth", "Change the passphrase of a keyfile", key.ChangeAuth())
 	})
+	app.Command("tx", "Create, sign and broadcast transactions", func(k *cli.Cmd) {
+		k.Command("send", "Create a send transaction", tx.Send())
+		k.Command("call", "Create a call transaction", tx.Call())
+		k.Command("deploy", "Create a transaction to deploy a contract", tx.Deploy())
+		k.Command("bond", "Create a bond transaction", tx.Bond())
+		k.Command("unbond", "Create an unbond transaction", tx.Unbond())
+		k.Command("permissions", "Create a permissions transaction", tx.Permissions())
+		k.Command("broadcast", "Broadcast a signed transaction file", tx.Broadcast())
+	})
+	app.Command("query", "Query the state of the blockchain from a node", func(k *cli.Cmd) {
+		k.Command("account", "Query an account", query.Account())
+		k.Command("validator", "Query a validator", query.Validator())
+		k.Command("validators", "Query the validators", query.Validators())
+		k.Command("storage", "Query the storage of a contract", query.Storage())
+		k.Command("block", "Query a block", query.Block())
+		k.Command("tx", "Query a transaction", query.Tx())
+		k.Command("status", "Query the status of the node", query.Status())
+		k.Command("genesis", "Query the genesis", query.Genesis())
+	})
 	app.Command("version", "Print the gallactic version", Version())
 	return app
 }
@@ -0,0 +1,99 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/rpc"
+	"github.com/jawher/mow.cli"
+)
+
+// Account queries an account by its address
+func Account() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		address := c.String(cli.StringArg{
+			Name: "ADDRESS",
+			Desc: "Address of the account",
+		})
+		opts := addQueryOptions(c)
+		c.Spec = "ADDRESS " + querySpec
+		c.Action = func() {
+			addr := parseAddress(*address)
+			opts.query(rpc.GET_ACCOUNT, rpc.AddressInput{Address: addr}, func(raw json.RawMessage) error {
+				out := new(rpc.AccountOutput)
+				if err := json.Unmarshal(raw, out); err != nil {
+					return err
+				}
+				acc := out.Account
+				if acc == nil {
+					return fmt.Errorf("There is no account with this address %s", addr)
+				}
+				t := newTable()
+				t.row("Address:", acc.Address())
+				t.row("Balance:", acc.Balance())
+				t.row("Sequence:", acc.Sequence())
+				t.row("Permissions:", acc.Permissions())
+				t.row("Code size:", len(acc.Code()))
+				t.flush()
+				return nil
+			})
+		}
+	}
+}
+
+// Storage queries the storage of a contract account
+func Storage() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		address := c.String(cli.StringArg{
+			Name: "ADDRESS",
+			Desc: "Address of the contract",
+		})
+		key := c.String(cli.StringOpt{
+			Name: "key",
+			Desc: "Storage key in hex format. If not set, the whole storage will be printed",
+		})
+		opts := addQueryOptions(c)
+		c.Spec = "ADDRESS [--key=<storage key>] " + querySpec
+		c.Action = func() {
+			addr := parseAddress(*address)
+			if *key != "" {
+				params := rpc.StorageAtInput{Address: addr, Key: parseHex("storage key", *key)}
+				opts.query(rpc.GET_STORAGE_AT, params, func(raw json.RawMessage) error {
+					out := new(rpc.StorageOutput)
+					if err := json.Unmarshal(raw, out); err != nil {
+						return err
+					}
+					t := newTable()
+					t.row("KEY", "VALUE")
+					t.row(out.Key, out.Value)
+					t.flush()
+					return nil
+				})
+				return
+			}
+
+			opts.query(rpc.GET_STORAGE, rpc.AddressInput{Address: addr}, func(raw json.RawMessage) error {
+				out := new(rpc.DumpstorageOutput)
+				if err := json.Unmarshal(raw, out); err != nil {
+					return err
+				}
+				t := newTable()
+				t.row("KEY", "VALUE")
+				for _, item := range out.StorageItems {
+					t.row(item.Key, item.Value)
+				}
+				t.flush()
+				return nil
+			})
+		}
+	}
+}
+
+func parseAddress(address string) crypto.Address {
+	addr, err := crypto.AddressFromString(address)
+	if err != nil {
+		fail("%v", err)
+	}
+	return addr
+}
@@ -0,0 +1,114 @@
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gallactic/gallactic/cmd"
+	"github.com/gallactic/gallactic/rpc"
+	"github.com/gallactic/gallactic/txs"
+	"github.com/jawher/mow.cli"
+)
+
+// Block queries a block by its height. Without height, the latest block is queried.
+func Block() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		height := c.Int(cli.IntArg{
+			Name: "HEIGHT",
+			Desc: "Height of the block. If not set, the latest block will be printed",
+		})
+		opts := addQueryOptions(c)
+		c.Spec = "[HEIGHT] " + querySpec
+		c.Action = func() {
+			method := rpc.GET_LATEST_BLOCK
+			var params interface{}
+			if *height < 0 {
+				fail("Height should not be negative")
+			}
+			if *height > 0 {
+				method = rpc.GET_BLOCK
+				params = rpc.BlockInput{Height: uint64(*height)}
+			}
+			opts.query(method, params, func(raw json.RawMessage) error {
+				out := new(rpc.BlockOutput)
+				if err := json.Unmarshal(raw, out); err != nil {
+					return err
+				}
+				if out.BlockMeta == nil || out.BlockMeta.BlockMeta == nil {
+					return fmt.Errorf("There is no block at this height")
+				}
+				meta := out.BlockMeta.BlockMeta
+				t := newTable()
+				t.row("Height:", meta.Header.Height)
+				t.row("Hash:", meta.BlockID.Hash)
+				t.row("Time:", meta.Header.Time)
+				t.row("Chain ID:", meta.Header.ChainID)
+				t.row("Proposer:", meta.Header.ProposerAddress)
+				t.row("Num txs:", meta.Header.NumTxs)
+				t.row("Total txs:", meta.Header.TotalTxs)
+				t.row("Last block hash:", meta.Header.LastBlockID.Hash)
+				t.row("App hash:", meta.Header.AppHash)
+				t.flush()
+				return nil
+			})
+		}
+	}
+}
+
+// Tx queries a committed transaction by its hash and the height of its block
+func Tx() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		hash := c.String(cli.StringArg{
+			Name: "HASH",
+			Desc: "Hash of the transaction in hex format",
+		})
+		height := c.Int(cli.IntOpt{
+			Name: "height",
+			Desc: "Height of the block which includes the transaction",
+		})
+		opts := addQueryOptions(c)
+		c.Spec = "--height=<block height> HASH " + querySpec
+		c.Action = func() {
+			txHash := parseHex("transaction hash", *hash)
+			if *height <= 0 {
+				fail("Height should be positive")
+			}
+			format := opts.format()
+
+			out := new(rpc.BlockTxsOutput)
+			params := rpc.BlockInput{Height: uint64(*height)}
+			if err := cmd.CallJSONRPC(*opts.node, rpc.GET_BLOCK_TXS, params, out); err != nil {
+				fail("Unable to query the node: %v", err)
+			}
+			var env *txs.Envelope
+			for i := range out.Txs {
+				if bytes.Equal(out.Txs[i].Hash(), txHash) {
+					env = &out.Txs[i]
+					break
+				}
+			}
+			if env == nil {
+				fail("There is no transaction with this hash in block %d", *height)
+			}
+
+			if format == outputJSON {
+				cmd.PrintJsonObject(env)
+				return
+			}
+			t := newTable()
+			t.row("Hash:", txHash)
+			t.row("Height:", *height)
+			t.row("Chain ID:", env.ChainID)
+			t.row("Type:", env.Type)
+			if env.Tx != nil {
+				t.row("Amount:", env.Tx.Amount())
+				t.row("Fee:", env.Tx.Fee())
+				for _, signer := range env.Tx.Signers() {
+					t.row("Signer:", fmt.Sprintf("%s (sequence: %d)", signer.Address, signer.Sequence))
+				}
+			}
+			t.flush()
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/gallactic/gallactic/cmd"
+	"github.com/gallactic/gallactic/common/binary"
+	"github.com/jawher/mow.cli"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// querySpec is the common part of the spec for all the query commands
+const querySpec = "[--node=<node address>] [-o=<output format>]"
+
+// queryOptions holds the common options of the query commands
+type queryOptions struct {
+	node   *string
+	output *string
+}
+
+func addQueryOptions(c *cli.Cmd) *queryOptions {
+	return &queryOptions{
+		node: c.String(cli.StringOpt{
+			Name:  "node",
+			Desc:  "JSON-RPC address of the node",
+			Value: cmd.DefaultNodeAddress,
+		}),
+		output: c.String(cli.StringOpt{
+			Name:  "o output",
+			Desc:  "Output format: table or json",
+			Value: outputTable,
+		}),
+	}
+}
+
+// query calls the JSON-RPC method and prints the result. In json mode the raw
+// result of the node is printed, otherwise the result is passed to printTable.
+func (opts *queryOptions) query(method string, params interface{}, printTable func(raw json.RawMessage) error) {
+	format := opts.format()
+
+	var raw json.RawMessage
+	if err := cmd.CallJSONRPC(*opts.node, method, params, &raw); err != nil {
+		fail("Unable to query the node: %v", err)
+	}
+
+	if format == outputJSON {
+		cmd.PrintJsonData(raw)
+		return
+	}
+	if err := printTable(raw); err != nil {
+		fail("Unable to decode the result: %v", err)
+	}
+}
+
+// format returns the output format, which is checked before querying the node
+func (opts *queryOptions) format() string {
+	format := strings.ToLower(*opts.output)
+	if format != outputTable && format != outputJSON {
+		fail("Invalid output format: %s. It should be 'table' or 'json'", *opts.output)
+	}
+	return format
+}
+
+// fail prints the error message and exits with non-zero code, so the
+// query commands can be used in scripts
+func fail(format string, a ...interface{}) {
+	cmd.PrintErrorMsg(format, a...)
+	os.Exit(1)
+}
+
+// parseHex decodes a hex string. The "0x" prefix is optional.
+func parseHex(name, str string) binary.HexBytes {
+	var bs binary.HexBytes
+	if err := bs.UnmarshalText([]byte(strings.TrimPrefix(str, "0x"))); err != nil {
+		fail("Invalid %s: %v", name, err)
+	}
+	return bs
+}
+
+// table prints aligned rows into the standard output
+type table struct {
+	w *tabwriter.Writer
+}
+
+func newTable() *table {
+	return &table{w: tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)}
+}
+
+// row adds a row to the table. Columns are separated by tabs.
+func (t *table) row(columns ...interface{}) {
+	strs := make([]string, len(columns))
+	for i, col := range columns {
+		strs[i] = fmt.Sprint(col)
+	}
+	fmt.Fprintln(t.w, strings.Join(strs, "\t"))
+}
+
+func (t *table) flush() {
+	t.w.Flush()
+}
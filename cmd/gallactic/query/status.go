@@ -0,0 +1,100 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gallactic/gallactic/common/binary"
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/rpc"
+	"github.com/jawher/mow.cli"
+	"github.com/tendermint/tendermint/p2p"
+)
+
+// statusOutput is the same as rpc.StatusOutput, but with the concrete type of NodeInfo to decode it
+type statusOutput struct {
+	NodeInfo          p2p.DefaultNodeInfo
+	GenesisHash       binary.HexBytes
+	PubKey            crypto.PublicKey
+	LatestBlockHash   binary.HexBytes
+	LatestBlockHeight uint64
+	LatestBlockTime   int64
+	NodeVersion       string
+}
+
+// Status queries the status of the node
+func Status() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		opts := addQueryOptions(c)
+		c.Spec = querySpec
+		c.Action = func() {
+			opts.query(rpc.GET_STATUS, nil, func(raw json.RawMessage) error {
+				out := new(statusOutput)
+				if err := json.Unmarshal(raw, out); err != nil {
+					return err
+				}
+				t := newTable()
+				t.row("Moniker:", out.NodeInfo.Moniker)
+				t.row("Node ID:", out.NodeInfo.ID())
+				t.row("Network:", out.NodeInfo.Network)
+				t.row("Listen address:", out.NodeInfo.ListenAddr)
+				t.row("Node version:", out.NodeVersion)
+				t.row("Public key:", out.PubKey)
+				t.row("Genesis hash:", out.GenesisHash)
+				t.row("Latest block height:", out.LatestBlockHeight)
+				t.row("Latest block hash:", out.LatestBlockHash)
+				if out.LatestBlockTime != 0 {
+					t.row("Latest block time:", time.Unix(0, out.LatestBlockTime).UTC())
+				}
+				t.flush()
+				return nil
+			})
+		}
+	}
+}
+
+// Genesis queries the genesis of the blockchain
+func Genesis() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		opts := addQueryOptions(c)
+		c.Spec = querySpec
+		c.Action = func() {
+			opts.query(rpc.GET_GENESIS, nil, func(raw json.RawMessage) error {
+				out := new(rpc.GenesisOutput)
+				if err := json.Unmarshal(raw, out); err != nil {
+					return err
+				}
+				gen := out.Genesis
+				if gen == nil {
+					return fmt.Errorf("Genesis is empty")
+				}
+				t := newTable()
+				t.row("Chain name:", gen.ChainName())
+				t.row("Chain ID:", gen.ChainID())
+				t.row("Genesis time:", gen.GenesisTime().UTC())
+				t.row("Maximum power:", gen.MaximumPower())
+				t.flush()
+
+				accs := gen.Accounts()
+				fmt.Printf("\nAccounts (%d):\n", len(accs))
+				t = newTable()
+				t.row("ADDRESS", "BALANCE", "PERMISSIONS")
+				for _, acc := range accs {
+					t.row(acc.Address(), acc.Balance(), acc.Permissions())
+				}
+				t.flush()
+
+				vals := gen.Validators()
+				fmt.Printf("\nValidators (%d):\n", len(vals))
+				t = newTable()
+				t.row("ADDRESS", "STAKE", "PUBLIC KEY")
+				for _, val := range vals {
+					t.row(val.Address(), val.Stake(), val.PublicKey())
+				}
+				t.flush()
+				return nil
+			})
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gallactic/gallactic/core/validator"
+	"github.com/gallactic/gallactic/rpc"
+	"github.com/jawher/mow.cli"
+)
+
+// Validator queries a validator by its address
+func Validator() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		address := c.String(cli.StringArg{
+			Name: "ADDRESS",
+			Desc: "Address of the validator",
+		})
+		opts := addQueryOptions(c)
+		c.Spec = "ADDRESS " + querySpec
+		c.Action = func() {
+			addr := parseAddress(*address)
+			opts.query(rpc.GET_VALIDATOR, rpc.AddressInput{Address: addr}, func(raw json.RawMessage) error {
+				out := new(rpc.ValidatorOutput)
+				if err := json.Unmarshal(raw, out); err != nil {
+					return err
+				}
+				val := out.Validator
+				if val == nil {
+					return fmt.Errorf("There is no validator with this address %s", addr)
+				}
+				t := newTable()
+				t.row("Address:", val.Address())
+				t.row("Public key:", val.PublicKey())
+				t.row("Stake:", val.Stake())
+				t.row("Sequence:", val.Sequence())
+				t.row("Bonding height:", val.BondingHeight())
+				t.flush()
+				return nil
+			})
+		}
+	}
+}
+
+// Validators queries the current validator set
+func Validators() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		status := c.String(cli.StringOpt{
+			Name: "status",
+			Desc: "Status of the validators: active (in the validator set) or inactive",
+		})
+		cursor := c.String(cli.StringOpt{
+			Name: "cursor",
+			Desc: "Address of the first validator of the page",
+		})
+		limit := c.Int(cli.IntOpt{
+			Name:  "limit",
+			Desc:  "Maximum number of the validators in the page",
+			Value: rpc.DefaultPageLimit,
+		})
+		opts := addQueryOptions(c)
+		c.Spec = "[--status=<status>] [--cursor=<address>] [--limit=<limit>] " + querySpec
+		c.Action = func() {
+			params := rpc.ValidatorsInput{
+				Status:    *status,
+				PageInput: rpc.PageInput{Cursor: *cursor, Limit: *limit},
+			}
+			opts.query(rpc.GET_VALIDATORS, params, func(raw json.RawMessage) error {
+				out := new(rpc.ValidatorsOutput)
+				if err := json.Unmarshal(raw, out); err != nil {
+					return err
+				}
+				fmt.Printf("Block height: %v\n\n", out.BlockHeight)
+				printValidators("Bonded validators", out.BondedValidators)
+				if len(out.UnbondingValidators) > 0 {
+					fmt.Println()
+					printValidators("Unbonding validators", out.UnbondingValidators)
+				}
+				if out.NextCursor != "" {
+					fmt.Printf("\nMore validators: --cursor=%s\n", out.NextCursor)
+				}
+				return nil
+			})
+		}
+	}
+}
+
+func printValidators(caption string, vals []*validator.Validator) {
+	fmt.Printf("%s (%d):\n", caption, len(vals))
+	t := newTable()
+	t.row("ADDRESS", "STAKE", "SEQUENCE", "BONDING HEIGHT")
+	for _, val := range vals {
+		t.row(val.Address(), val.Stake(), val.Sequence(), val.BondingHeight())
+	}
+	t.flush()
+}
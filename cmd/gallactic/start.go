@@ -38,8 +38,12 @@ func Start() func(c *cli.Cmd) {
 			Name: "a auth",
 			Desc: "Key file's passphrase",
 		})
+		allowMissingSignInfo := c.Bool(cli.BoolOpt{
+			Name: "allow-missing-sign-info",
+			Desc: "Start the node even if the validator's last signed info is missing. It may cause double signing",
+		})
 
-		c.Spec = "[-w=<working directory>] [-p=<validator's private key>] | [-k=<path to the key file>] [-a=<key file's password>]"
+		c.Spec = "[-w=<working directory>] [--allow-missing-sign-info] [-p=<validator's private key>] | [-k=<path to the key file>] [-a=<key file's password>]"
 		c.LongDesc = "Starting the node"
 		c.Before = func() { fmt.Println(title) }
 		c.Action = func() {
@@ -132,7 +136,7 @@ func Start() func(c *cli.Cmd) {
 			defer cancel()
 
 			signer := crypto.NewValidatorSigner(keyObj.PrivateKey())
-			kernel, err := core.NewKernel(ctx, gen, conf, signer)
+			kernel, err := core.NewKernel(ctx, gen, conf, signer, *allowMissingSignInfo)
 			if err != nil {
 				cmd.PrintErrorMsg("Could not create kernel. %v", err)
 				return
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gallactic/gallactic/cmd"
+	"github.com/gallactic/gallactic/common"
+	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/account/permission"
+	"github.com/gallactic/gallactic/core/config"
+	"github.com/gallactic/gallactic/core/proposal"
+	"github.com/gallactic/gallactic/core/validator"
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/keystore/key"
+	cli "github.com/jawher/mow.cli"
+	"github.com/tendermint/tendermint/p2p"
+)
+
+// Each node in the testnet uses the default ports shifted by (node index * portStep)
+const portStep = 10
+
+// Testnet initializes the working directories for a local testnet
+func Testnet() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		numValidators := c.Int(cli.IntOpt{
+			Name:  "v validators",
+			Desc:  "Number of validators (nodes) in the testnet",
+			Value: 4,
+		})
+		numAccounts := c.Int(cli.IntOpt{
+			Name:  "accounts",
+			Desc:  "Number of funded accounts in the genesis",
+			Value: 4,
+		})
+		outputDir := c.String(cli.StringOpt{
+			Name:  "o output",
+			Desc:  "Directory to save the nodes' working directories",
+			Value: "./testnet",
+		})
+		chainName := c.String(cli.StringOpt{
+			Name: "n chain-name",
+			Desc: "A name for the blockchain",
+		})
+		host := c.String(cli.StringOpt{
+			Name:  "host",
+			Desc:  "Host address of the nodes, used to set the persistent peers",
+			Value: "127.0.0.1",
+		})
+
+		c.Spec = "[-v=<number of validators>] [--accounts=<number of accounts>] [-o=<output directory>] [-n=<a name for the blockchain>] [--host=<host address>]"
+		c.LongDesc = "Initializing the working directories for a local testnet. All nodes share the same genesis and are connected to each other"
+		c.Before = func() { fmt.Println(title) }
+		c.Action = func() {
+			if *numValidators < 1 {
+				cmd.PrintErrorMsg("Number of validators should be at least 1")
+				return
+			}
+			if *numAccounts < 0 {
+				cmd.PrintErrorMsg("Number of accounts should not be negative")
+				return
+			}
+			if *chainName == "" {
+				*chainName = fmt.Sprintf("test-chain-%v", common.RandomHex(2))
+			}
+
+			path, _ := filepath.Abs(*outputDir)
+			nodeDirs := make([]string, *numValidators)
+			confs := make([]*config.Config, *numValidators)
+			peers := make([]string, *numValidators)
+			vals := make([]*validator.Validator, *numValidators)
+
+			for i := 0; i < *numValidators; i++ {
+				nodeDirs[i] = filepath.Join(path, fmt.Sprintf("node%d", i))
+				confs[i] = makeTestnetConfig(i)
+
+				// create validator key
+				k := key.GenValidatorKey()
+				if err := key.EncryptKeyFile(k, nodeDirs[i]+"/validator_key.json", "", ""); err != nil {
+					cmd.PrintErrorMsg("Failed to write validator key file: %v", err)
+					return
+				}
+				vals[i], _ = validator.NewValidator(k.PublicKey(), 0)
+
+				// create node key, the node ID is needed for the persistent peers
+				nodeKeyFile := filepath.Join(nodeDirs[i], confs[i].Tendermint.NodeKeyFile())
+				if err := common.Mkdir(filepath.Dir(nodeKeyFile)); err != nil {
+					cmd.PrintErrorMsg("Failed to create node directory: %v", err)
+					return
+				}
+				nodeKey, err := p2p.LoadOrGenNodeKey(nodeKeyFile)
+				if err != nil {
+					cmd.PrintErrorMsg("Failed to create node key: %v", err)
+					return
+				}
+				peers[i] = fmt.Sprintf("%s@%s:%d", nodeKey.ID(), *host, 46656+i*portStep)
+			}
+
+			// create accounts for genesis
+			accs := make([]*account.Account, *numAccounts)
+			for i := 0; i < len(accs); i++ {
+				k := key.GenAccountKey()
+				if err := key.EncryptKeyFile(k, path+"/keys/"+k.Address().String()+".json", "", ""); err != nil {
+					cmd.PrintErrorMsg("Failed to write account key file: %v", err)
+					return
+				}
+				acc, _ := account.NewAccount(k.Address())
+				acc.AddToBalance(10000000000000000000)
+				accs[i] = acc
+			}
+
+			// create global account
+			gAcc, _ := account.NewAccount(crypto.GlobalAddress)
+			gAcc.SetPermissions(permission.AllPermissions)
+
+			tm := time.Now().Truncate(0).UTC()
+			gen := proposal.MakeGenesis(*chainName, tm, gAcc, accs, nil, vals)
+
+			for i, nodeDir := range nodeDirs {
+				otherPeers := make([]string, 0, len(peers)-1)
+				for j, peer := range peers {
+					if i != j {
+						otherPeers = append(otherPeers, peer)
+					}
+				}
+				confs[i].Tendermint.P2P.PersistentPeers = strings.Join(otherPeers, ",")
+
+				if err := gen.SaveToFile(nodeDir + "/genesis.json"); err != nil {
+					cmd.PrintErrorMsg("Failed to write genesis file: %v", err)
+					return
+				}
+				if err := confs[i].SaveToFile(nodeDir + "/config.toml"); err != nil {
+					cmd.PrintErrorMsg("Failed to write config file: %v", err)
+					return
+				}
+			}
+
+			fmt.Println()
+			cmd.PrintSuccessMsg("A testnet with %d nodes is successfully initialized at %v", *numValidators, path)
+			for i, nodeDir := range nodeDirs {
+				cmd.PrintInfoMsg("node%d: gallactic start -w %s (JSON-RPC port: %d)",
+					i, nodeDir, confs[i].RPC.Server.Bind.Port)
+			}
+		}
+	}
+}
+
+// makeTestnetConfig makes the configuration of the i-th node with non-conflicting ports
+func makeTestnetConfig(i int) *config.Config {
+	conf := makeConfigfile()
+	offset := i * portStep
+
+	conf.Tendermint.Moniker = fmt.Sprintf("node%d", i)
+	conf.Tendermint.P2P.ListenAddress = fmt.Sprintf("tcp://0.0.0.0:%d", 46656+offset)
+	conf.Tendermint.RPC.ListenAddress = fmt.Sprintf("tcp://localhost:%d", 46657+offset)
+	// All nodes run on the same host
+	conf.Tendermint.P2P.AddrBookStrict = false
+	conf.Tendermint.P2P.AllowDuplicateIP = true
+	conf.RPC.Server.Bind.Port = uint16(1337 + offset)
+	conf.GRPC.ListenAddress = fmt.Sprintf("0.0.0.0:%d", 50051+offset)
+	conf.GRPC.HTTPAddress = fmt.Sprintf("0.0.0.0:%d", 50052+offset)
+	conf.Metrics.ListenAddress = fmt.Sprintf("0.0.0.0:%d", 26660+offset)
+
+	return conf
+}
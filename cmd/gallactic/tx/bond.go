@@ -0,0 +1,56 @@
+package tx
+
+import (
+	"fmt"
+
+	"github.com/gallactic/gallactic/cmd"
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/txs/tx"
+	"github.com/jawher/mow.cli"
+)
+
+// Bond bonds coins from the key file's account to a validator
+func Bond() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		pubKey := c.String(cli.StringOpt{
+			Name: "pubkey",
+			Desc: "Public key of the validator",
+		})
+		amount := c.Int(cli.IntOpt{
+			Name: "amount",
+			Desc: "Amount to bond",
+		})
+		opts := addTxOptions(c)
+
+		c.Spec = "--pubkey=<validator's public key> --amount=<amount>" + txSpec
+		c.LongDesc = "Creating a bond transaction"
+		c.Before = func() { fmt.Println(title) }
+		c.Action = func() {
+			valPubKey, err := crypto.PublicKeyFromString(*pubKey)
+			if err != nil {
+				cmd.PrintErrorMsg("Invalid public key: %v", err)
+				return
+			}
+			amt, err := parseAmount("Amount", *amount)
+			if err != nil {
+				cmd.PrintErrorMsg("%v", err)
+				return
+			}
+			fee, err := parseAmount("Fee", *opts.fee)
+			if err != nil {
+				cmd.PrintErrorMsg("%v", err)
+				return
+			}
+			keyObj, err := opts.loadKey()
+			if err != nil {
+				cmd.PrintErrorMsg("Failed to decrypt: %v", err)
+				return
+			}
+			signer := crypto.NewAccountSigner(keyObj.PrivateKey())
+
+			opts.signAndProcess(signer, opts.accountSequence(signer.Address()), func(seq uint64) (tx.Tx, error) {
+				return tx.NewBondTx(signer.Address(), valPubKey, amt, seq, fee)
+			})
+		}
+	}
+}
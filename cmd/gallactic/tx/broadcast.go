@@ -0,0 +1,47 @@
+package tx
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/gallactic/gallactic/cmd"
+	"github.com/gallactic/gallactic/txs"
+	"github.com/jawher/mow.cli"
+)
+
+// Broadcast broadcasts a signed transaction file
+func Broadcast() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		txFile := c.String(cli.StringArg{
+			Name: "FILE",
+			Desc: "Path to the signed transaction file",
+		})
+		node := c.String(cli.StringOpt{
+			Name:  "node",
+			Desc:  "JSON-RPC address of the node",
+			Value: cmd.DefaultNodeAddress,
+		})
+
+		c.Spec = "FILE [--node=<node address>]"
+		c.LongDesc = "Broadcasting a signed transaction"
+		c.Before = func() { fmt.Println(title) }
+		c.Action = func() {
+			bs, err := ioutil.ReadFile(*txFile)
+			if err != nil {
+				cmd.PrintErrorMsg("Failed to read the file: %v", err)
+				return
+			}
+			env := new(txs.Envelope)
+			if err := json.Unmarshal(bs, env); err != nil {
+				cmd.PrintErrorMsg("Invalid transaction: %v", err)
+				return
+			}
+			if err := env.Verify(); err != nil {
+				cmd.PrintErrorMsg("Invalid transaction: %v", err)
+				return
+			}
+			broadcastEnvelope(env, *node)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package tx
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/gallactic/gallactic/cmd"
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/txs/tx"
+	"github.com/jawher/mow.cli"
+)
+
+// Call calls a contract
+func Call() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		to := c.String(cli.StringOpt{
+			Name: "to",
+			Desc: "Contract's address",
+		})
+		data := c.String(cli.StringOpt{
+			Name: "d data",
+			Desc: "Hex encoded input data of the call",
+		})
+		amount := c.Int(cli.IntOpt{
+			Name: "amount",
+			Desc: "Amount to transfer to the contract",
+		})
+		gasLimit := c.Int(cli.IntOpt{
+			Name:  "gas-limit",
+			Desc:  "Maximum gas for the execution",
+			Value: 1000000,
+		})
+		opts := addTxOptions(c)
+
+		c.Spec = "--to=<contract address> [-d=<input data>] [--amount=<amount>] [--gas-limit=<gas limit>]" + txSpec
+		c.LongDesc = "Creating a call transaction"
+		c.Before = func() { fmt.Println(title) }
+		c.Action = func() {
+			callee, err := crypto.AddressFromString(*to)
+			if err != nil {
+				cmd.PrintErrorMsg("Invalid contract address: %v", err)
+				return
+			}
+			input, err := decodeHex(*data)
+			if err != nil {
+				cmd.PrintErrorMsg("Invalid input data: %v", err)
+				return
+			}
+			callTx(opts, callee, input, *amount, *gasLimit)
+		}
+	}
+}
+
+// Deploy deploys a new contract
+func Deploy() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		code := c.String(cli.StringOpt{
+			Name: "c code",
+			Desc: "Hex encoded byte code of the contract",
+		})
+		codeFile := c.String(cli.StringOpt{
+			Name: "f file",
+			Desc: "Path to the file contains hex encoded byte code of the contract",
+		})
+		amount := c.Int(cli.IntOpt{
+			Name: "amount",
+			Desc: "Amount to transfer to the contract",
+		})
+		gasLimit := c.Int(cli.IntOpt{
+			Name:  "gas-limit",
+			Desc:  "Maximum gas for the execution",
+			Value: 1000000,
+		})
+		opts := addTxOptions(c)
+
+		c.Spec = "(-c=<byte code> | -f=<byte code file>) [--amount=<amount>] [--gas-limit=<gas limit>]" + txSpec
+		c.LongDesc = "Creating a call transaction to deploy a new contract"
+		c.Before = func() { fmt.Println(title) }
+		c.Action = func() {
+			hexCode := *code
+			if *codeFile != "" {
+				bs, err := ioutil.ReadFile(*codeFile)
+				if err != nil {
+					cmd.PrintErrorMsg("Failed to read the file: %v", err)
+					return
+				}
+				hexCode = string(bs)
+			}
+			byteCode, err := decodeHex(hexCode)
+			if err != nil {
+				cmd.PrintErrorMsg("Invalid byte code: %v", err)
+				return
+			}
+			if len(byteCode) == 0 {
+				cmd.PrintErrorMsg("Byte code is empty")
+				return
+			}
+			callTx(opts, crypto.Address{}, byteCode, *amount, *gasLimit)
+		}
+	}
+}
+
+func callTx(opts *txOptions, callee crypto.Address, data []byte, amount, gasLimit int) {
+	amt, err := parseAmount("Amount", amount)
+	if err != nil {
+		cmd.PrintErrorMsg("%v", err)
+		return
+	}
+	gas, err := parseAmount("Gas limit", gasLimit)
+	if err != nil {
+		cmd.PrintErrorMsg("%v", err)
+		return
+	}
+	fee, err := parseAmount("Fee", *opts.fee)
+	if err != nil {
+		cmd.PrintErrorMsg("%v", err)
+		return
+	}
+	keyObj, err := opts.loadKey()
+	if err != nil {
+		cmd.PrintErrorMsg("Failed to decrypt: %v", err)
+		return
+	}
+	signer := crypto.NewAccountSigner(keyObj.PrivateKey())
+
+	opts.signAndProcess(signer, opts.accountSequence(signer.Address()), func(seq uint64) (tx.Tx, error) {
+		return tx.NewCallTx(signer.Address(), callee, seq, data, gas, amt, fee)
+	})
+}
+
+func decodeHex(str string) ([]byte, error) {
+	str = strings.TrimSpace(str)
+	str = strings.TrimPrefix(str, "0x")
+	return hex.DecodeString(str)
+}
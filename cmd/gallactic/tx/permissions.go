@@ -0,0 +1,66 @@
+package tx
+
+import (
+	"fmt"
+
+	"github.com/gallactic/gallactic/cmd"
+	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/account/permission"
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/txs/tx"
+	"github.com/jawher/mow.cli"
+)
+
+// Permissions sets or unsets the permissions of an account
+func Permissions() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		modified := c.String(cli.StringOpt{
+			Name: "account",
+			Desc: "Address of the account to modify its permissions",
+		})
+		perms := c.String(cli.StringOpt{
+			Name: "perm",
+			Desc: "Permissions in hex format, e.g. 0x0002 for Send permission",
+		})
+		unset := c.Bool(cli.BoolOpt{
+			Name: "unset",
+			Desc: "Unset the permissions instead of setting them",
+		})
+		opts := addTxOptions(c)
+
+		c.Spec = "--account=<account address> --perm=<permissions> [--unset]" + txSpec
+		c.LongDesc = "Creating a permissions transaction. The key file's account should have the ModifyPermission permission"
+		c.Before = func() { fmt.Println(title) }
+		c.Action = func() {
+			modifiedAddr, err := crypto.AddressFromString(*modified)
+			if err != nil {
+				cmd.PrintErrorMsg("Invalid account address: %v", err)
+				return
+			}
+			var perm account.Permissions
+			if err := perm.UnmarshalText([]byte(*perms)); err != nil {
+				cmd.PrintErrorMsg("Invalid permissions: %v", err)
+				return
+			}
+			if perm == permission.None || !permission.EnsureValid(perm) {
+				cmd.PrintErrorMsg("Invalid permissions: %v", perm)
+				return
+			}
+			fee, err := parseAmount("Fee", *opts.fee)
+			if err != nil {
+				cmd.PrintErrorMsg("%v", err)
+				return
+			}
+			keyObj, err := opts.loadKey()
+			if err != nil {
+				cmd.PrintErrorMsg("Failed to decrypt: %v", err)
+				return
+			}
+			signer := crypto.NewAccountSigner(keyObj.PrivateKey())
+
+			opts.signAndProcess(signer, opts.accountSequence(signer.Address()), func(seq uint64) (tx.Tx, error) {
+				return tx.NewPermissionsTx(signer.Address(), modifiedAddr, perm, !*unset, seq, fee)
+			})
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package tx
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/gallactic/gallactic/cmd"
+	"github.com/gallactic/gallactic/common"
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/keystore/key"
+	"github.com/gallactic/gallactic/rpc"
+	"github.com/gallactic/gallactic/txs"
+	"github.com/gallactic/gallactic/txs/tx"
+	"github.com/jawher/mow.cli"
+)
+
+var title = `
+              .__  .__                 __  .__            __
+   _________  |  | |  | _____    _____/  |_|__| ____    _/  |____  ___
+  / ___\__  \ |  | |  | \__  \ _/ ___\   __\  |/ ___\   \   __\  \/  /
+ / /_/  > __ \|  |_|  |__/ __ \\  \___|  | |  \  \___    |  |  >    <
+ \___  (____  /____/____(____  /\___  >__| |__|\___  >   |__| /__/\_ \
+/_____/     \/               \/     \/             \/               \/
+`
+
+// txSpec is the common part of the spec for all the transaction commands
+const txSpec = " -k=<path to the key file> [-a=<key file's passphrase>] [--fee=<fee>]" +
+	" [--node=<node address>] [--seq=<sequence>] [--chain-id=<chain id>] [--valid-until=<height>]" +
+	" [-o=<output file>]"
+
+// txOptions holds the common options of the transaction commands
+type txOptions struct {
+	keyFile    *string
+	auth       *string
+	fee        *int
+	node       *string
+	sequence   *string
+	chainID    *string
+	validUntil *string
+	output     *string
+}
+
+func addTxOptions(c *cli.Cmd) *txOptions {
+	return &txOptions{
+		keyFile: c.String(cli.StringOpt{
+			Name: "k keyfile",
+			Desc: "Path to the encrypted key file to sign the transaction",
+		}),
+		auth: c.String(cli.StringOpt{
+			Name: "a auth",
+			Desc: "Key file's passphrase",
+		}),
+		fee: c.Int(cli.IntOpt{
+			Name:  "fee",
+			Desc:  "Transaction fee",
+			Value: 0,
+		}),
+		node: c.String(cli.StringOpt{
+			Name:  "node",
+			Desc:  "JSON-RPC address of the node",
+			Value: cmd.DefaultNodeAddress,
+		}),
+		sequence: c.String(cli.StringOpt{
+			Name: "seq",
+			Desc: "Sequence of the transaction. If not set, it will be fetched from the node",
+		}),
+		chainID: c.String(cli.StringOpt{
+			Name: "chain-id",
+			Desc: "Chain ID of the blockchain. If not set, it will be fetched from the node",
+		}),
+		validUntil: c.String(cli.StringOpt{
+			Name: "valid-until",
+			Desc: "Last block height that the transaction can be included in. If not set, the transaction never expires",
+		}),
+		output: c.String(cli.StringOpt{
+			Name: "o output",
+			Desc: "Write the signed transaction into this file instead of broadcasting it (offline mode)",
+		}),
+	}
+}
+
+// loadKey decrypts the key file. It prompts for the passphrase if it is not provided.
+func (opts *txOptions) loadKey() (*key.Key, error) {
+	passphrase := *opts.auth
+	if passphrase == "" {
+		passphrase = cmd.PromptPassphrase("Passphrase: ", false)
+	}
+	return key.DecryptKeyFile(*opts.keyFile, passphrase)
+}
+
+// nextSequence returns the sequence of the transaction
+func (opts *txOptions) nextSequence(getSequence func() (uint64, error)) (uint64, error) {
+	if *opts.sequence != "" {
+		return strconv.ParseUint(*opts.sequence, 10, 64)
+	}
+	seq, err := getSequence()
+	if err != nil {
+		return 0, err
+	}
+	return seq + 1, nil
+}
+
+func (opts *txOptions) getChainID() (string, error) {
+	if *opts.chainID != "" {
+		return *opts.chainID, nil
+	}
+	out := new(rpc.ChainIdOutput)
+	if err := cmd.CallJSONRPC(*opts.node, rpc.GET_CHAIN_ID, nil, out); err != nil {
+		return "", err
+	}
+	return out.ChainId, nil
+}
+
+func (opts *txOptions) validUntilHeight() (uint64, error) {
+	if *opts.validUntil == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(*opts.validUntil, 10, 64)
+}
+
+func (opts *txOptions) accountSequence(addr crypto.Address) func() (uint64, error) {
+	return func() (uint64, error) {
+		out := new(rpc.AccountOutput)
+		if err := cmd.CallJSONRPC(*opts.node, rpc.GET_ACCOUNT, rpc.AddressInput{Address: addr}, out); err != nil {
+			return 0, err
+		}
+		if out.Account == nil {
+			return 0, fmt.Errorf("There is no account with this address %s", addr)
+		}
+		return out.Account.Sequence(), nil
+	}
+}
+
+func (opts *txOptions) validatorSequence(addr crypto.Address) func() (uint64, error) {
+	return func() (uint64, error) {
+		out := new(rpc.ValidatorOutput)
+		if err := cmd.CallJSONRPC(*opts.node, rpc.GET_VALIDATOR, rpc.AddressInput{Address: addr}, out); err != nil {
+			return 0, err
+		}
+		if out.Validator == nil {
+			return 0, fmt.Errorf("There is no validator with this address %s", addr)
+		}
+		return out.Validator.Sequence(), nil
+	}
+}
+
+// signAndProcess builds the transaction, signs it and then
+// broadcasts it or writes it into the output file in offline mode.
+func (opts *txOptions) signAndProcess(signer crypto.Signer,
+	getSequence func() (uint64, error), makeTx func(seq uint64) (tx.Tx, error)) {
+
+	seq, err := opts.nextSequence(getSequence)
+	if err != nil {
+		cmd.PrintErrorMsg("Unable to get the sequence: %v", err)
+		return
+	}
+	chainID, err := opts.getChainID()
+	if err != nil {
+		cmd.PrintErrorMsg("Unable to get the chain ID: %v", err)
+		return
+	}
+	validUntil, err := opts.validUntilHeight()
+	if err != nil {
+		cmd.PrintErrorMsg("Invalid valid-until height: %v", err)
+		return
+	}
+	t, err := makeTx(seq)
+	if err != nil {
+		cmd.PrintErrorMsg("Unable to create the transaction: %v", err)
+		return
+	}
+	env := txs.Enclose(chainID, t)
+	env.ValidUntilHeight = validUntil
+	if err := env.Sign(signer); err != nil {
+		cmd.PrintErrorMsg("Unable to sign the transaction: %v", err)
+		return
+	}
+
+	if *opts.output != "" {
+		writeEnvelope(env, *opts.output)
+		return
+	}
+	broadcastEnvelope(env, *opts.node)
+}
+
+func writeEnvelope(env *txs.Envelope, file string) {
+	bs, err := json.MarshalIndent(env, "", "  ")
+	if err != nil {
+		cmd.PrintErrorMsg("Unable to encode the transaction: %v", err)
+		return
+	}
+	if err := common.WriteFile(file, bs); err != nil {
+		cmd.PrintErrorMsg("%v", err)
+		return
+	}
+	fmt.Println()
+	cmd.PrintSuccessMsg("Signed transaction is written to %v", file)
+	cmd.PrintInfoMsg("Transaction hash: %X", env.Hash())
+}
+
+func broadcastEnvelope(env *txs.Envelope, node string) {
+	receipt := new(txs.Receipt)
+	if err := cmd.CallJSONRPC(node, rpc.BROADCAST_TX, env, receipt); err != nil {
+		cmd.PrintErrorMsg("Unable to broadcast the transaction: %v", err)
+		return
+	}
+	fmt.Println()
+	if receipt.Status == txs.Ok {
+		cmd.PrintSuccessMsg("Transaction is executed successfully")
+	} else {
+		cmd.PrintWarnMsg("Transaction is failed")
+	}
+	cmd.PrintJsonObject(receipt)
+}
+
+// parseAmount returns the given amount as uint64
+func parseAmount(name string, amount int) (uint64, error) {
+	if amount < 0 {
+		return 0, fmt.Errorf("%s should not be negative", name)
+	}
+	return uint64(amount), nil
+}
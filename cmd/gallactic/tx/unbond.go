@@ -0,0 +1,56 @@
+package tx
+
+import (
+	"fmt"
+
+	"github.com/gallactic/gallactic/cmd"
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/txs/tx"
+	"github.com/jawher/mow.cli"
+)
+
+// Unbond unbonds the stake of the key file's validator to an account
+func Unbond() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		to := c.String(cli.StringOpt{
+			Name: "to",
+			Desc: "Receiver's account address",
+		})
+		amount := c.Int(cli.IntOpt{
+			Name: "amount",
+			Desc: "Amount to unbond",
+		})
+		opts := addTxOptions(c)
+
+		c.Spec = "--to=<receiver address> --amount=<amount>" + txSpec
+		c.LongDesc = "Creating an unbond transaction. The key file should belong to the validator"
+		c.Before = func() { fmt.Println(title) }
+		c.Action = func() {
+			receiver, err := crypto.AddressFromString(*to)
+			if err != nil {
+				cmd.PrintErrorMsg("Invalid receiver address: %v", err)
+				return
+			}
+			amt, err := parseAmount("Amount", *amount)
+			if err != nil {
+				cmd.PrintErrorMsg("%v", err)
+				return
+			}
+			fee, err := parseAmount("Fee", *opts.fee)
+			if err != nil {
+				cmd.PrintErrorMsg("%v", err)
+				return
+			}
+			keyObj, err := opts.loadKey()
+			if err != nil {
+				cmd.PrintErrorMsg("Failed to decrypt: %v", err)
+				return
+			}
+			signer := crypto.NewValidatorSigner(keyObj.PrivateKey())
+
+			opts.signAndProcess(signer, opts.validatorSequence(signer.Address()), func(seq uint64) (tx.Tx, error) {
+				return tx.NewUnbondTx(signer.Address(), receiver, amt, seq, fee)
+			})
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gallactic/gallactic/common"
+	"github.com/gallactic/gallactic/rpc"
+)
+
+// DefaultNodeAddress is the JSON-RPC endpoint of a local node with the default config
+const DefaultNodeAddress = "http://localhost:1337/rpc"
+
+// APIKeyEnv is the environment variable of the API key which is sent to the node
+const APIKeyEnv = "GALLACTIC_API_KEY"
+
+var httpClient = &http.Client{Timeout: 2 * time.Minute}
+
+// CallJSONRPC calls a JSON-RPC method of the node and decodes the result
+func CallJSONRPC(node, method string, params interface{}, result interface{}) error {
+	var rawParams json.RawMessage
+	if params != nil {
+		bs, err := json.Marshal(params)
+		if err != nil {
+			return err
+		}
+		rawParams = bs
+	}
+
+	req := rpc.NewRPCRequest(common.RandomHex(8), method, rawParams)
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequest("POST", node, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if apiKey := os.Getenv(APIKeyEnv); apiKey != "" {
+		httpReq.Header.Set("X-API-Key", apiKey)
+	}
+
+	resp, err := httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("Unable to connect to the node: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusUnauthorized {
+		return fmt.Errorf("Invalid API key. Set it by %s environment variable", APIKeyEnv)
+	}
+
+	var res struct {
+		Result json.RawMessage `json:"result"`
+		Error  *rpc.RPCError   `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return fmt.Errorf("Invalid response from the node: %v", err)
+	}
+	if res.Error != nil {
+		return res.Error
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(res.Result, result)
+}
@@ -6,17 +6,24 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/gallactic/gallactic/core/logging"
 	"github.com/gallactic/gallactic/core/proposal"
 	"github.com/gallactic/gallactic/core/sortition"
 	"github.com/gallactic/gallactic/core/state"
 	"github.com/gallactic/gallactic/core/validator"
 	"github.com/gallactic/gallactic/crypto"
-	log "github.com/inconshreveable/log15"
 	dbm "github.com/tendermint/tendermint/libs/db"
 )
 
+var logger = logging.New(logging.ModuleState)
+
 var stateKey = []byte("BlockchainState")
 
+// Indexer writes the index of the committed block into the batch of the block
+type Indexer interface {
+	Index(batch dbm.Batch) error
+}
+
 type Blockchain struct {
 	chainID      string
 	genesisHash  []byte
@@ -25,6 +32,7 @@ type Blockchain struct {
 	data         *blockchainData
 	validatorSet *validator.ValidatorSet
 	sortition    *sortition.Sortition
+	indexer      Indexer
 }
 
 type blockchainData struct {
@@ -39,7 +47,7 @@ type blockchainData struct {
 
 func LoadOrNewBlockchain(db dbm.DB, gen *proposal.Genesis, myVal crypto.Signer) (*Blockchain, error) {
 
-	log.Info("Trying to load blockchain state from database")
+	logger.Info("Trying to load blockchain state from database")
 
 	bc, err := loadBlockchain(db)
 	if err != nil {
@@ -55,7 +63,7 @@ func LoadOrNewBlockchain(db dbm.DB, gen *proposal.Genesis, myVal crypto.Signer)
 		}
 	} else {
 
-		log.Info("No existing blockchain state found in database, making new blockchain")
+		logger.Info("No existing blockchain state found in database, making new blockchain")
 
 		bc, err = newBlockchain(db, gen)
 		if err != nil {
@@ -76,12 +84,8 @@ func LoadOrNewBlockchain(db dbm.DB, gen *proposal.Genesis, myVal crypto.Signer)
 
 // Pointer to blockchain state initialized from genesis
 func newBlockchain(db dbm.DB, gen *proposal.Genesis) (*Blockchain, error) {
-	if len(gen.Validators()) == 0 {
-		return nil, fmt.Errorf("The genesis file has no validators")
-	}
-
-	if gen.GenesisTime().IsZero() {
-		return nil, fmt.Errorf("Genesis time didn't set inside genesis doc")
+	if err := gen.ValidateForStartup(); err != nil {
+		return nil, err
 	}
 
 	st := state.NewState(db)
@@ -111,6 +115,12 @@ func newBlockchain(db dbm.DB, gen *proposal.Genesis) (*Blockchain, error) {
 			LastValidators: gen.ValidatorsAddress(),
 		},
 	}
+
+	batch := db.NewBatch()
+	if err := bc.saveValidatorSet(batch, 0, nil, bc.data.LastValidators, nil); err != nil {
+		return nil, err
+	}
+	batch.WriteSync()
 	return bc, nil
 }
 
@@ -155,6 +165,14 @@ func (bc *Blockchain) LastBlockHash() []byte      { return bc.data.LastBlockHash
 func (bc *Blockchain) LastAppHash() []byte        { return bc.data.LastAppHash }
 func (bc *Blockchain) MaximumPower() int          { return bc.data.MaximumPower }
 
+// LastValidators returns the addresses of the validator set at the last block
+func (bc *Blockchain) LastValidators() []crypto.Address { return bc.data.LastValidators }
+
+// SetIndexer sets the indexer which is written with each committed block
+func (bc *Blockchain) SetIndexer(indexer Indexer) {
+	bc.indexer = indexer
+}
+
 func (bc *Blockchain) ValidatorSet() *validator.ValidatorSet {
 	return bc.validatorSet
 }
@@ -177,6 +195,25 @@ func (bc *Blockchain) CommitBlock(blockTime time.Time, blockHash []byte) ([]byte
 	for addr, _ := range bc.validatorSet.Validators() {
 		vals = append(vals, addr)
 	}
+	leavers := make([]crypto.Address, 0)
+	for addr := range bc.validatorSet.Leavers() {
+		leavers = append(leavers, addr)
+	}
+
+	if bc.db != nil {
+		// The validator set and the index of the block are written in one batch. If we die before that, we resume
+		// from the previous block and both are written again when Tendermint replays this block
+		batch := bc.db.NewBatch()
+		if err := bc.saveValidatorSet(batch, bc.data.LastBlockHeight+1, bc.data.LastValidators, vals, leavers); err != nil {
+			return nil, err
+		}
+		if bc.indexer != nil {
+			if err := bc.indexer.Index(batch); err != nil {
+				return nil, err
+			}
+		}
+		batch.WriteSync()
+	}
 
 	bc.data.LastBlockHeight++
 	bc.data.LastBlockTime = blockTime
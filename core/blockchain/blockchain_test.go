@@ -49,3 +49,73 @@ func TestPersistedState(t *testing.T) {
 
 	assert.Equal(t, bc1.data, bc2.data)
 }
+
+func TestValidatorSetHistory(t *testing.T) {
+	pb1, _ := crypto.GenerateKey(nil)
+	pb2, _ := crypto.GenerateKey(nil)
+	val1, _ := validator.NewValidator(pb1, 0)
+	val2, _ := validator.NewValidator(pb2, 0)
+	addr1, addr2 := val1.Address(), val2.Address()
+
+	gAcc, _ := account.NewAccount(crypto.GlobalAddress)
+	gen := proposal.MakeGenesis("bar", time.Now().UTC().Truncate(0), gAcc, nil, nil, []*validator.Validator{val1})
+	bc, err := LoadOrNewBlockchain(dbm.NewMemDB(), gen, nil)
+	require.NoError(t, err)
+
+	_, err = bc.CommitBlock(time.Now(), []byte{1})
+	require.NoError(t, err)
+	require.NoError(t, bc.validatorSet.Join(val2))
+	_, err = bc.CommitBlock(time.Now(), []byte{2})
+	require.NoError(t, err)
+	require.NoError(t, bc.validatorSet.ForceLeave(addr1))
+	_, err = bc.CommitBlock(time.Now(), []byte{3})
+	require.NoError(t, err)
+
+	rec, err := bc.ValidatorSetAt(0)
+	require.NoError(t, err)
+	assert.Equal(t, []crypto.Address{addr1}, rec.Validators)
+	rec, err = bc.ValidatorSetAt(2)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), rec.Height)
+	assert.Equal(t, 2, len(rec.Validators))
+	assert.Empty(t, rec.Leavers)
+	rec, err = bc.ValidatorSetAt(3)
+	require.NoError(t, err)
+	assert.Equal(t, []crypto.Address{addr2}, rec.Validators)
+	_, err = bc.ValidatorSetAt(4)
+	assert.Error(t, err)
+
+	history1, err := bc.ValidatorHistory(addr1)
+	require.NoError(t, err)
+	assert.Equal(t, []ValidatorEvent{{Height: 0, Type: ValidatorJoined}, {Height: 3, Type: ValidatorLeft}}, history1)
+	history2, err := bc.ValidatorHistory(addr2)
+	require.NoError(t, err)
+	assert.Equal(t, []ValidatorEvent{{Height: 2, Type: ValidatorJoined}}, history2)
+}
+
+type testIndexer struct{ height int }
+
+func (ti *testIndexer) Index(batch dbm.Batch) error {
+	ti.height++
+	batch.Set([]byte("test-index"), []byte{byte(ti.height)})
+	return nil
+}
+
+func TestIndexer(t *testing.T) {
+	pb, _ := crypto.GenerateKey(nil)
+	val, _ := validator.NewValidator(pb, 0)
+	gAcc, _ := account.NewAccount(crypto.GlobalAddress)
+	gen := proposal.MakeGenesis("bar", time.Now().UTC().Truncate(0), gAcc, nil, nil, []*validator.Validator{val})
+	db := dbm.NewMemDB()
+	bc, err := LoadOrNewBlockchain(db, gen, nil)
+	require.NoError(t, err)
+	bc.SetIndexer(&testIndexer{})
+	assert.Nil(t, db.Get([]byte("test-index")))
+
+	// The index is written with the validator set of the block
+	_, err = bc.CommitBlock(time.Now().UTC().Truncate(0), []byte{1})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1}, db.Get([]byte("test-index")))
+	_, err = bc.ValidatorSetAt(1)
+	require.NoError(t, err)
+}
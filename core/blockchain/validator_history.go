@@ -0,0 +1,136 @@
+package blockchain
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/gallactic/gallactic/crypto"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+const (
+	// Prefix of the keys of the validator set at each height
+	validatorSetPrefix = "vs/"
+	// Prefix of the keys of the join and leave events of each validator
+	validatorEventPrefix = "ve/"
+)
+
+// Types of the validator events
+const (
+	ValidatorJoined = "join"
+	ValidatorLeft   = "leave"
+)
+
+// ValidatorSetRecord is the validator set at the end of a block. Leavers are the
+// validators which are removed from the set by adjusting the power of the set.
+type ValidatorSetRecord struct {
+	Height     uint64           `json:"height"`
+	Validators []crypto.Address `json:"validators"`
+	Leavers    []crypto.Address `json:"leavers"`
+}
+
+// ValidatorEvent is joining or leaving the validator set at a height
+type ValidatorEvent struct {
+	Height uint64 `json:"height"`
+	Type   string `json:"type"`
+}
+
+func heightBytes(height uint64) []byte {
+	bs := make([]byte, 8)
+	binary.BigEndian.PutUint64(bs, height)
+	return bs
+}
+
+func validatorSetKey(height uint64) []byte {
+	return append([]byte(validatorSetPrefix), heightBytes(height)...)
+}
+
+func validatorEventPrefixKey(addr crypto.Address) []byte {
+	return append([]byte(validatorEventPrefix), addr.RawBytes()...)
+}
+
+func validatorEventKey(addr crypto.Address, height uint64) []byte {
+	return append(validatorEventPrefixKey(addr), heightBytes(height)...)
+}
+
+func sortAddresses(addrs []crypto.Address) {
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].RawBytes(), addrs[j].RawBytes()) < 0
+	})
+}
+
+// saveValidatorSet records the validator set at the height and the events of
+// the validators which joined or left the set since the previous validator set.
+func (bc *Blockchain) saveValidatorSet(batch dbm.Batch, height uint64, prev, vals, leavers []crypto.Address) error {
+	rec := &ValidatorSetRecord{
+		Height:     height,
+		Validators: append([]crypto.Address{}, vals...),
+		Leavers:    append([]crypto.Address{}, leavers...),
+	}
+	sortAddresses(rec.Validators)
+	sortAddresses(rec.Leavers)
+	bs, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	batch.Set(validatorSetKey(height), bs)
+
+	prevSet := make(map[crypto.Address]bool)
+	for _, addr := range prev {
+		prevSet[addr] = true
+	}
+	addEvent := func(addr crypto.Address, typ string) error {
+		bs, err := json.Marshal(&ValidatorEvent{Height: height, Type: typ})
+		if err != nil {
+			return err
+		}
+		batch.Set(validatorEventKey(addr, height), bs)
+		return nil
+	}
+	for _, addr := range rec.Validators {
+		if prevSet[addr] {
+			delete(prevSet, addr)
+		} else if err := addEvent(addr, ValidatorJoined); err != nil {
+			return err
+		}
+	}
+	for addr := range prevSet {
+		if err := addEvent(addr, ValidatorLeft); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidatorSetAt returns the validator set at the end of the block at the height.
+// The genesis validators are recorded at height zero.
+func (bc *Blockchain) ValidatorSetAt(height uint64) (*ValidatorSetRecord, error) {
+	bs := bc.db.Get(validatorSetKey(height))
+	if bs == nil {
+		return nil, fmt.Errorf("There is no validator set recorded at height %d", height)
+	}
+	rec := new(ValidatorSetRecord)
+	if err := json.Unmarshal(bs, rec); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// ValidatorHistory returns the join and leave events of the validator ordered by height
+func (bc *Blockchain) ValidatorHistory(addr crypto.Address) ([]ValidatorEvent, error) {
+	events := make([]ValidatorEvent, 0)
+	it := dbm.IteratePrefix(bc.db, validatorEventPrefixKey(addr))
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var event ValidatorEvent
+		if err := json.Unmarshal(it.Value(), &event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
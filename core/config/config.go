@@ -10,8 +10,10 @@ import (
 	"github.com/BurntSushi/toml"
 	"github.com/gallactic/gallactic/common"
 	sputnikvmConfig "github.com/gallactic/gallactic/core/evm/sputnikvm/config"
+	"github.com/gallactic/gallactic/rpc/auth"
 	rpcConfig "github.com/gallactic/gallactic/rpc/config"
 	grpcConfig "github.com/gallactic/gallactic/rpc/grpc/config"
+	"github.com/gallactic/gallactic/rpc/limit"
 	tmConfig "github.com/tendermint/tendermint/config"
 )
 
@@ -19,7 +21,13 @@ type Config struct {
 	Tendermint *tmConfig.Config                 `toml:"Tendermint"`
 	RPC        *rpcConfig.RPCConfig             `toml:"RPC"`
 	GRPC       *grpcConfig.GRPCConfig           `toml:"GRPC"`
+	Auth       *auth.Config                     `toml:"Auth,omitempty"`
+	Limits     *limit.Config                    `toml:"Limits,omitempty"`
 	Logging    *Logging                         `toml:"Logging,omitempty"`
+	Metrics    *Metrics                         `toml:"Metrics,omitempty"`
+	Keys       *Keys                            `toml:"Keys,omitempty"`
+	Transactor *Transactor                      `toml:"Transactor,omitempty"`
+	Mempool    *Mempool                         `toml:"Mempool,omitempty"`
 	SputnikVM  *sputnikvmConfig.SputnikvmConfig `toml:"SputnikVM"`
 }
 
@@ -37,6 +45,13 @@ func DefaultConfig() *Config {
 		Tendermint: tmDef,
 		RPC:        rpcConfig.DefaultRPCConfig(),
 		GRPC:       grpcConfig.DefaultGRPCConfig(),
+		Auth:       auth.DefaultConfig(),
+		Limits:     limit.DefaultConfig(),
+		Logging:    DefaultLogging(),
+		Metrics:    DefaultMetrics(),
+		Keys:       DefaultKeys(),
+		Transactor: DefaultTransactor(),
+		Mempool:    DefaultMempool(),
 		SputnikVM:  sputnikvmConfig.DefaultSputnikvmConfig(),
 	}
 }
@@ -0,0 +1,17 @@
+package config
+
+// Keys are the node-managed keys. The node signs the transactions of BroadcastTxAuto with them.
+type Keys struct {
+	Enabled bool
+	// Dir is the directory of the key files, like the `keys` directory of the working directory
+	Dir string
+	// Passphrase decrypts the key files
+	Passphrase string
+}
+
+func DefaultKeys() *Keys {
+	return &Keys{
+		Enabled: false,
+		Dir:     "keys",
+	}
+}
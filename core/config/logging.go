@@ -1,4 +1,71 @@
 package config
 
+import "time"
+
+// Logging configures the log levels, the output sinks and the sampling of the logs
 type Logging struct {
+	// Level is the default log level: debug, info, warn, error or crit
+	Level string
+	// Modules overrides the log level of the modules: consensus, execution, rpc, evm and state.
+	// The other modules use the default log level.
+	Modules  map[string]string
+	Stderr   *StderrSink
+	File     *FileSink
+	Sampling *Sampling
+}
+
+// StderrSink writes the logs into the standard error
+type StderrSink struct {
+	Enabled bool
+	// Format is the log format: terminal or json
+	Format string
+}
+
+// FileSink writes the logs into a file. The file is rotated when it reaches MaxSize.
+type FileSink struct {
+	Enabled bool
+	Path    string
+	// Format is the log format: terminal or json
+	Format string
+	// Level is the minimum level of the logs written into the file
+	Level string
+	// MaxSize is the maximum size of the file in megabytes before it gets rotated.
+	// Zero means no rotation.
+	MaxSize int
+	// MaxBackups is the maximum number of rotated files to keep
+	MaxBackups int
+}
+
+// Sampling drops the repetitive debug logs. For each module and message,
+// the first Initial logs in every Tick are written and then every Thereafter-th log.
+type Sampling struct {
+	Enabled    bool
+	Initial    int
+	Thereafter int
+	Tick       time.Duration
+}
+
+func DefaultLogging() *Logging {
+	return &Logging{
+		Level:   "info",
+		Modules: map[string]string{},
+		Stderr: &StderrSink{
+			Enabled: true,
+			Format:  "terminal",
+		},
+		File: &FileSink{
+			Enabled:    true,
+			Path:       "errors.log",
+			Format:     "json",
+			Level:      "error",
+			MaxSize:    100,
+			MaxBackups: 5,
+		},
+		Sampling: &Sampling{
+			Enabled:    false,
+			Initial:    100,
+			Thereafter: 100,
+			Tick:       time.Second,
+		},
+	}
 }
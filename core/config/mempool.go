@@ -0,0 +1,21 @@
+package config
+
+// Mempool is the node's policy for accepting the transactions into its mempool.
+// It's a local policy, other nodes may include the rejected transactions in their blocks.
+// It doesn't order the transactions by fee, blocks are still built in FIFO order.
+type Mempool struct {
+	// MinFee is the minimum fee of the transactions
+	MinFee uint64
+	// MinFeePerByte is the minimum fee per byte of the encoded transactions
+	MinFeePerByte uint64
+	// MinFeePerGas is the minimum fee per unit of the gas limit of the call transactions
+	MinFeePerGas uint64
+}
+
+func DefaultMempool() *Mempool {
+	return &Mempool{
+		MinFee:        0,
+		MinFeePerByte: 0,
+		MinFeePerGas:  0,
+	}
+}
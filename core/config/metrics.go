@@ -0,0 +1,14 @@
+package config
+
+// Metrics serves the Prometheus metrics of the node on the `/metrics` path of the listen address
+type Metrics struct {
+	Enabled       bool
+	ListenAddress string
+}
+
+func DefaultMetrics() *Metrics {
+	return &Metrics{
+		Enabled:       false,
+		ListenAddress: "0.0.0.0:26660",
+	}
+}
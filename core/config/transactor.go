@@ -0,0 +1,14 @@
+package config
+
+// Transactor configures the broadcasting of the transactions
+type Transactor struct {
+	// CommitTimeout is the time in seconds which broadcastTx and broadcastTxCommit
+	// wait for the transaction to be committed
+	CommitTimeout uint64
+}
+
+func DefaultTransactor() *Transactor {
+	return &Transactor{
+		CommitTimeout: 100,
+	}
+}
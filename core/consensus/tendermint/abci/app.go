@@ -3,20 +3,26 @@ package abci
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"sync"
 
 	"github.com/gallactic/gallactic/core/blockchain"
 	"github.com/gallactic/gallactic/core/consensus/tendermint/codes"
+	"github.com/gallactic/gallactic/core/events"
 	"github.com/gallactic/gallactic/core/execution"
+	"github.com/gallactic/gallactic/core/logging"
+	"github.com/gallactic/gallactic/core/metrics"
 	"github.com/gallactic/gallactic/crypto"
+	e "github.com/gallactic/gallactic/errors"
 	"github.com/gallactic/gallactic/txs"
 	"github.com/gallactic/gallactic/version"
-	log "github.com/inconshreveable/log15"
 	"github.com/pkg/errors"
 	abciTypes "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/common"
 )
 
+var logger = logging.New(logging.ModuleConsensus)
+
 const responseInfoName = "Gallactic"
 
 type App struct {
@@ -24,18 +30,23 @@ type App struct {
 	bc            *blockchain.Blockchain
 	checker       execution.BatchExecutor
 	committer     execution.BatchCommitter
+	eventBus      events.EventBus
 	mempoolLocker sync.Locker
+	feePolicy     execution.FeePolicy
 	// We need to cache these from BeginBlock for when we need actually need it in Commit
 	block *abciTypes.RequestBeginBlock
+	// Gas used by the transactions of the current block
+	blockGasUsed uint64
 }
 
 var _ abciTypes.Application = &App{}
 
-func NewApp(bc *blockchain.Blockchain, checker execution.BatchExecutor, committer execution.BatchCommitter) *App {
+func NewApp(bc *blockchain.Blockchain, checker execution.BatchExecutor, committer execution.BatchCommitter, eventBus events.EventBus) *App {
 	return &App{
 		bc:        bc,
 		checker:   checker,
 		committer: committer,
+		eventBus:  eventBus,
 	}
 }
 
@@ -46,6 +57,11 @@ func (app *App) SetMempoolLocker(mempoolLocker sync.Locker) {
 	app.mempoolLocker = mempoolLocker
 }
 
+// SetFeePolicy sets the minimum fee of the transactions which are accepted into the mempool
+func (app *App) SetFeePolicy(feePolicy execution.FeePolicy) {
+	app.feePolicy = feePolicy
+}
+
 func (app *App) Info(info abciTypes.RequestInfo) abciTypes.ResponseInfo {
 	return abciTypes.ResponseInfo{
 		Data:             responseInfoName,
@@ -70,21 +86,35 @@ func (app *App) Query(reqQuery abciTypes.RequestQuery) (respQuery abciTypes.Resp
 func (app *App) CheckTx(txBytes []byte) abciTypes.ResponseCheckTx {
 	txEnv := new(txs.Envelope)
 	if err := txEnv.Decode(txBytes); err != nil {
-		log.Error("CheckTx decoding error",
+		logger.Error("CheckTx decoding error",
 			"error", err)
 
+		metrics.CheckTxRejected("encoding")
 		return abciTypes.ResponseCheckTx{
 			Code: codes.EncodingErrorCode,
 			Log:  fmt.Sprintf("Encoding error: %s", err),
 		}
 	}
+	if err := app.feePolicy.Check(txEnv); err != nil {
+		logger.Debug("CheckTx rejected by the fee policy",
+			"error", err,
+			"tx_hash", txEnv.Hash())
+
+		metrics.CheckTxRejected(strconv.Itoa(e.Code(err)))
+		return abciTypes.ResponseCheckTx{
+			Code: codes.InsufficientFeeCode,
+			Log:  fmt.Sprintf("CheckTx rejected transaction: %s, error: %v", txEnv, err),
+		}
+	}
 	txRec := txEnv.GenerateReceipt()
 	err := app.checker.Execute(txEnv, txRec)
 	if err != nil {
-		log.Error("CheckTx execution error",
+		logger.Error("CheckTx execution error",
 			"error", err,
 			"tx_hash", txRec.Hash)
 
+		metrics.CheckTxRejected(strconv.Itoa(e.Code(err)))
+
 		return abciTypes.ResponseCheckTx{
 			Code: codes.EncodingErrorCode,
 			Log:  fmt.Sprintf("CheckTx could not execute transaction: %s, error: %v", txEnv, err),
@@ -98,7 +128,7 @@ func (app *App) CheckTx(txBytes []byte) abciTypes.ResponseCheckTx {
 			Log:  fmt.Sprintf("CheckTx could not serialize receipt: %s", err),
 		}
 	}
-	log.Debug("CheckTx success",
+	logger.Debug("CheckTx success",
 		"tx_hash", txRec.Hash)
 
 	return abciTypes.ResponseCheckTx{
@@ -115,6 +145,7 @@ func (app *App) InitChain(chain abciTypes.RequestInitChain) (respInitChain abciT
 
 func (app *App) BeginBlock(block abciTypes.RequestBeginBlock) (respBeginBlock abciTypes.ResponseBeginBlock) {
 	app.block = &block
+	app.blockGasUsed = 0
 
 	set := app.bc.ValidatorSet()
 	state := app.bc.State()
@@ -139,7 +170,7 @@ func (app *App) BeginBlock(block abciTypes.RequestBeginBlock) (respBeginBlock ab
 func (app *App) DeliverTx(txBytes []byte) abciTypes.ResponseDeliverTx {
 	txEnv := new(txs.Envelope)
 	if err := txEnv.Decode(txBytes); err != nil {
-		log.Error("DeliverTx decoding error",
+		logger.Error("DeliverTx decoding error",
 			"error", err)
 
 		app.mempoolLocker.Unlock()
@@ -151,8 +182,10 @@ func (app *App) DeliverTx(txBytes []byte) abciTypes.ResponseDeliverTx {
 
 	txRec := txEnv.GenerateReceipt()
 	txRec.Height = app.block.Header.Height
-	if err := app.committer.Execute(txEnv, txRec); err != nil {
-		log.Error("DeliverTx execution error",
+	err := app.committer.Execute(txEnv, txRec)
+	app.blockGasUsed += txRec.GasUsed
+	if err != nil {
+		logger.Error("DeliverTx execution error",
 			"error", err,
 			"tx_hash", txRec.Hash)
 
@@ -192,6 +225,7 @@ func (app *App) EndBlock(reqEndBlock abciTypes.RequestEndBlock) abciTypes.Respon
 	set.AdjustPower(reqEndBlock.GetHeight())
 	vals := set.Validators()
 	leavers := set.Leavers()
+	metrics.SetValidatorSetSize(len(vals))
 
 	updates := make([]abciTypes.ValidatorUpdate, len(vals)+len(leavers))
 	i := 0
@@ -213,7 +247,7 @@ func (app *App) EndBlock(reqEndBlock abciTypes.RequestEndBlock) abciTypes.Respon
 }
 
 func (app *App) Commit() abciTypes.ResponseCommit {
-	log.Debug("Committing block",
+	logger.Debug("Committing block",
 		"height", app.block.Header.Height,
 		"hash", fmt.Sprintf("%X", app.block.Hash),
 		"txs", app.block.Header.NumTxs)
@@ -243,6 +277,8 @@ func (app *App) Commit() abciTypes.ResponseCommit {
 		}
 	}()
 
+	metrics.BlockGasUsed(app.blockGasUsed)
+
 	// First commit the app start, this app hash will not get checkpointed until the next block when we are sure
 	// that nothing in the downstream commit process could have failed. At worst we go back one block.
 	err := app.committer.Commit()
@@ -281,7 +317,22 @@ func (app *App) Commit() abciTypes.ResponseCommit {
 			"but Tendermint reports a block height of %v, and the two should agree",
 			app.bc.LastBlockHeight(), app.block.Header.Height))
 	}
+
+	app.fireBlockEvent(appHash)
 	return abciTypes.ResponseCommit{
 		Data: appHash,
 	}
 }
+
+func (app *App) fireBlockEvent(appHash []byte) {
+	block := &events.Block{
+		Height:  app.block.Header.Height,
+		Hash:    app.block.Hash,
+		Time:    app.block.Header.Time,
+		NumTxs:  app.block.Header.NumTxs,
+		AppHash: appHash,
+	}
+	if err := app.eventBus.Publish(block, events.TagsForBlock()); err != nil {
+		logger.Error("Error publishing block event", "error", err, "height", block.Height)
+	}
+}
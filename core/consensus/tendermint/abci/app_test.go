@@ -0,0 +1,71 @@
+package abci
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/account/permission"
+	"github.com/gallactic/gallactic/core/blockchain"
+	"github.com/gallactic/gallactic/core/consensus/tendermint/codes"
+	"github.com/gallactic/gallactic/core/events"
+	"github.com/gallactic/gallactic/core/execution"
+	"github.com/gallactic/gallactic/core/proposal"
+	"github.com/gallactic/gallactic/core/validator"
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/keystore/key"
+	"github.com/gallactic/gallactic/txs"
+	"github.com/gallactic/gallactic/txs/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func TestRecheckExpiredTx(t *testing.T) {
+	k1, k2 := key.GenAccountKey(), key.GenAccountKey()
+	acc1, _ := account.NewAccount(k1.Address())
+	acc1.AddToBalance(1000)
+	acc2, _ := account.NewAccount(k2.Address())
+	acc2.AddToBalance(1000)
+	valKey := key.GenValidatorKey()
+	val, _ := validator.NewValidator(valKey.PublicKey(), 0)
+	gAcc, _ := account.NewAccount(crypto.GlobalAddress)
+	gAcc.SetPermissions(permission.AllPermissions)
+	gen := proposal.MakeGenesis("recheck", time.Now().UTC().Truncate(0), gAcc,
+		[]*account.Account{acc1, acc2}, nil, []*validator.Validator{val})
+	bc, err := blockchain.LoadOrNewBlockchain(dbm.NewMemDB(), gen, crypto.NewValidatorSigner(valKey.PrivateKey()))
+	require.NoError(t, err)
+
+	eventBus := events.NewNopeEventBus()
+	app := NewApp(bc, execution.NewBatchChecker(bc), execution.NewBatchCommitter(bc, eventBus, nil), eventBus)
+
+	makeTx := func(k *key.Key, validUntil uint64) []byte {
+		signer := crypto.NewAccountSigner(k.PrivateKey())
+		sendTx, err := tx.NewSendTx(signer.Address(), key.GenAccountKey().Address(), 1, 100, 1)
+		require.NoError(t, err)
+		txEnv := txs.Enclose(gen.ChainID(), sendTx)
+		txEnv.ValidUntilHeight = validUntil
+		require.NoError(t, txEnv.Sign(signer))
+		bs, err := txEnv.Encode()
+		require.NoError(t, err)
+		return bs
+	}
+	expiring := makeTx(k1, 1)
+	valid := makeTx(k2, 2)
+
+	// Both are accepted into the mempool for the first block
+	assert.Equal(t, codes.TxExecutionSuccessCode, app.CheckTx(expiring).Code)
+	assert.Equal(t, codes.TxExecutionSuccessCode, app.CheckTx(valid).Code)
+
+	// The first block doesn't include them
+	app.BeginBlock(abciTypes.RequestBeginBlock{Hash: []byte{1}, Header: abciTypes.Header{Height: 1, Time: time.Now()}})
+	app.EndBlock(abciTypes.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	// The mempool rechecks them for the second block and evicts the expired one
+	res := app.CheckTx(expiring)
+	assert.NotEqual(t, codes.TxExecutionSuccessCode, res.Code)
+	assert.Contains(t, res.Log, "valid until height 1")
+	assert.Equal(t, codes.TxExecutionSuccessCode, app.CheckTx(valid).Code)
+}
@@ -11,6 +11,9 @@ const (
 	// Informational
 	UnsupportedRequestCode uint32 = 400
 
+	// Rejected by the node's policy
+	InsufficientFeeCode uint32 = 402
+
 	// Internal errors
 	EncodingErrorCode    uint32 = 500
 	TxExecutionErrorCode uint32 = 501
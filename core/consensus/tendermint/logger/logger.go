@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"github.com/gallactic/gallactic/core/logging"
 	log "github.com/inconshreveable/log15"
 	tmFlags "github.com/tendermint/tendermint/libs/cli/flags"
 	tmLog "github.com/tendermint/tendermint/libs/log"
@@ -10,9 +11,11 @@ type tendermintLogger struct {
 	log.Logger
 }
 
+// NewLoggerF returns a Tendermint logger which logs into the consensus module.
+// The filter sets the level of the Tendermint's modules, e.g. "state:info,*:error".
 func NewLoggerF(filter string, keyvals ...interface{}) tmLog.Logger {
 	l := &tendermintLogger{
-		log.New(),
+		logging.New(logging.ModuleConsensus),
 	}
 
 	tLogger, err := tmFlags.ParseLogLevel(filter, l, "*:info")
@@ -20,13 +23,22 @@ func NewLoggerF(filter string, keyvals ...interface{}) tmLog.Logger {
 		panic("Unable to start tendermint logger: " + err.Error())
 	}
 
-	return tLogger
+	return tLogger.With(keyvals...)
 }
 
 func NewLogger(keyvals ...interface{}) tmLog.Logger {
-	return NewLoggerF("*:debug")
+	return NewLoggerF("*:debug", keyvals...)
 }
 
+// With keeps the context of the logger. Tendermint's module is renamed to
+// "tm_module" so it doesn't override the gallactic's module.
 func (tml *tendermintLogger) With(keyvals ...interface{}) tmLog.Logger {
-	return NewLogger(keyvals)
+	ctx := make([]interface{}, len(keyvals))
+	copy(ctx, keyvals)
+	for i := 0; i+1 < len(ctx); i += 2 {
+		if ctx[i] == "module" {
+			ctx[i] = "tm_module"
+		}
+	}
+	return &tendermintLogger{tml.Logger.New(ctx...)}
 }
@@ -49,6 +49,11 @@ func (nv *NodeView) MempoolTransactions(maxTxs int) ([]*txs.Envelope, error) {
 	return transactions, nil
 }
 
+// IsCatchingUp returns true if the node is syncing the blocks with its peers
+func (nv *NodeView) IsCatchingUp() bool {
+	return nv.tmNode.ConsensusReactor().FastSync()
+}
+
 func (nv *NodeView) RoundState() *consensusTypes.RoundState {
 	return nv.tmNode.ConsensusState().GetRoundState()
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/gallactic/gallactic/core/blockchain"
 	"github.com/gallactic/gallactic/core/consensus/tendermint/abci"
 	tmLogger "github.com/gallactic/gallactic/core/consensus/tendermint/logger"
+	"github.com/gallactic/gallactic/core/events"
 	"github.com/gallactic/gallactic/core/execution"
 	"github.com/gallactic/gallactic/core/proposal"
 	tmConfig "github.com/tendermint/tendermint/config"
@@ -44,7 +45,8 @@ func (n *Node) Close() {
 }
 
 func NewNode(conf *tmConfig.Config, privValidator tmTypes.PrivValidator, gen *tmTypes.GenesisDoc,
-	bc *blockchain.Blockchain, checker execution.BatchExecutor, committer execution.BatchCommitter) (*Node, error) {
+	bc *blockchain.Blockchain, checker execution.BatchExecutor, committer execution.BatchCommitter, eventBus events.EventBus,
+	feePolicy execution.FeePolicy) (*Node, error) {
 
 	err := common.Mkdir(path.Dir(conf.NodeKeyFile()))
 	if err != nil {
@@ -57,10 +59,11 @@ func NewNode(conf *tmConfig.Config, privValidator tmTypes.PrivValidator, gen *tm
 		PrometheusListenAddr: "",
 	})
 
-	tmLogger := tmLogger.NewLoggerF(conf.LogLevel).With("module", "Tendermint")
+	tmLogger := tmLogger.NewLoggerF(conf.LogLevel)
 
 	n := &Node{}
-	app := abci.NewApp(bc, checker, committer)
+	app := abci.NewApp(bc, checker, committer, eventBus)
+	app.SetFeePolicy(feePolicy)
 	client := proxy.NewLocalClientCreator(app)
 	nodeKey, _ := p2p.LoadOrGenNodeKey(conf.NodeKeyFile())
 	n.Node, err = node.NewNode(conf, privValidator, nodeKey, client,
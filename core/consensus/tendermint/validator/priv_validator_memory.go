@@ -19,11 +19,12 @@ var _ tmTypes.PrivValidator = &privValidatorMemory{}
 
 // Create a PrivValidator with in-memory state that takes an addressable representing the validator identity
 // and a signer providing private signing for that identity.
-func NewPrivValidatorMemory(signer crypto.Signer) *privValidatorMemory {
+// The last signed info keeps track of the signed data and persists it if it is backed by a file.
+func NewPrivValidatorMemory(signer crypto.Signer, lastSignedInfo *LastSignedInfo) *privValidatorMemory {
 	return &privValidatorMemory{
 		publicKey:      signer.PublicKey().TMPubKey(),
 		signer:         asTendermintSigner(signer),
-		lastSignedInfo: NewLastSignedInfo(),
+		lastSignedInfo: lastSignedInfo,
 	}
 }
 
@@ -35,7 +36,6 @@ func (pvm *privValidatorMemory) GetPubKey() tmCrypto.PubKey {
 	return pvm.publicKey
 }
 
-// TODO: consider persistence to disk/database to avoid double signing after a crash
 func (pvm *privValidatorMemory) SignVote(chainID string, vote *tmTypes.Vote) error {
 	return pvm.lastSignedInfo.SignVote(pvm.signer, chainID, vote)
 }
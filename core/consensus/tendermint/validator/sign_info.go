@@ -2,8 +2,10 @@ package validator
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"sync"
 	"time"
 
@@ -43,12 +45,31 @@ type LastSignedInfo struct {
 	Step      int8         `json:"step"`
 	Signature []byte       `json:"signature,omitempty"` // so we dont lose signatures
 	SignBytes cmn.HexBytes `json:"signBytes,omitempty"` // so we dont lose signatures
+
+	filePath string
 }
 
-func NewLastSignedInfo() *LastSignedInfo {
+// NewLastSignedInfo creates an empty LastSignedInfo. If filePath is not empty,
+// the info is persisted into this file each time something is signed.
+func NewLastSignedInfo(filePath string) *LastSignedInfo {
 	return &LastSignedInfo{
-		Step: stepNone,
+		Step:     stepNone,
+		filePath: filePath,
+	}
+}
+
+// LoadLastSignedInfo loads the last signed info from the given file.
+// Subsequent signing will be persisted into the same file.
+func LoadLastSignedInfo(filePath string) (*LastSignedInfo, error) {
+	bs, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	lsi := NewLastSignedInfo(filePath)
+	if err := json.Unmarshal(bs, lsi); err != nil {
+		return nil, fmt.Errorf("Error reading last signed info from %v: %v", filePath, err)
 	}
+	return lsi, nil
 }
 
 type tmSigner func(msg []byte) []byte
@@ -134,7 +155,9 @@ func (lsi *LastSignedInfo) signVote(sign tmSigner, chainID string, vote *tmTypes
 
 	// It passed the checks. Sign the vote
 	sig := sign(signBytes)
-	lsi.saveSigned(height, round, step, signBytes, sig)
+	if err := lsi.saveSigned(height, round, step, signBytes, sig); err != nil {
+		return err
+	}
 	vote.Signature = sig
 	return nil
 }
@@ -170,20 +193,49 @@ func (lsi *LastSignedInfo) signProposal(sign tmSigner, chainID string, proposal
 
 	// It passed the checks. Sign the proposal
 	sig := sign(signBytes)
-	lsi.saveSigned(height, round, step, signBytes, sig)
+	if err := lsi.saveSigned(height, round, step, signBytes, sig); err != nil {
+		return err
+	}
 	proposal.Signature = sig
 	return nil
 }
 
 // Persist height/round/step and signature
 func (lsi *LastSignedInfo) saveSigned(height int64, round int, step int8,
-	signBytes []byte, sig []byte) {
+	signBytes []byte, sig []byte) error {
+
+	// Write to the disk first. The signature should not be released if it can't be persisted.
+	if lsi.filePath != "" {
+		signed := &LastSignedInfo{
+			Height:    height,
+			Round:     round,
+			Step:      step,
+			Signature: sig,
+			SignBytes: signBytes,
+		}
+		if err := signed.writeFile(lsi.filePath); err != nil {
+			return err
+		}
+	}
 
 	lsi.Height = height
 	lsi.Round = round
 	lsi.Step = step
 	lsi.Signature = sig
 	lsi.SignBytes = signBytes
+	return nil
+}
+
+// writeFile writes the last signed info into the file atomically.
+func (lsi *LastSignedInfo) writeFile(filePath string) error {
+	bs, err := json.MarshalIndent(lsi, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := cmn.WriteFileAtomic(filePath, bs, 0600); err != nil {
+		return fmt.Errorf("Unable to persist last signed info: %v", err)
+	}
+	return nil
 }
 
 // String returns a string representation of the LastSignedInfo.
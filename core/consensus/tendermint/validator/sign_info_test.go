@@ -0,0 +1,74 @@
+package validator
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+func fakeSigner(msg []byte) []byte {
+	return append([]byte("sig:"), msg...)
+}
+
+func newVote(height int64, round int, voteType tmTypes.SignedMsgType, blockHash []byte) *tmTypes.Vote {
+	return &tmTypes.Vote{
+		Type:      voteType,
+		Height:    height,
+		Round:     round,
+		Timestamp: time.Now().UTC(),
+		BlockID:   tmTypes.BlockID{Hash: blockHash},
+	}
+}
+
+func TestPersistLastSignedInfo(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gallactic")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "last_signed_info.json")
+
+	lsi := NewLastSignedInfo(file)
+	vote := newVote(10, 1, tmTypes.PrevoteType, []byte("block_hash_1"))
+	require.NoError(t, lsi.SignVote(fakeSigner, "test-chain", vote))
+
+	// Simulate a restart
+	lsi2, err := LoadLastSignedInfo(file)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), lsi2.Height)
+	assert.Equal(t, 1, lsi2.Round)
+	assert.Equal(t, stepPrevote, lsi2.Step)
+	assert.Equal(t, vote.Signature, lsi2.Signature)
+	assert.Equal(t, vote.SignBytes("test-chain"), lsi2.SignBytes.Bytes())
+
+	// Signing the same vote again, returns the same signature
+	vote2 := newVote(10, 1, tmTypes.PrevoteType, []byte("block_hash_1"))
+	vote2.Timestamp = vote.Timestamp
+	require.NoError(t, lsi2.SignVote(fakeSigner, "test-chain", vote2))
+	assert.Equal(t, vote.Signature, vote2.Signature)
+
+	// Conflicting vote at the same height/round/step
+	vote3 := newVote(10, 1, tmTypes.PrevoteType, []byte("block_hash_2"))
+	assert.Error(t, lsi2.SignVote(fakeSigner, "test-chain", vote3))
+
+	// Height regression
+	vote4 := newVote(9, 0, tmTypes.PrecommitType, []byte("block_hash_1"))
+	assert.Error(t, lsi2.SignVote(fakeSigner, "test-chain", vote4))
+}
+
+func TestSignatureNotReleasedIfNotPersisted(t *testing.T) {
+	lsi := NewLastSignedInfo("/non_existing_dir/last_signed_info.json")
+	vote := newVote(10, 1, tmTypes.PrevoteType, []byte("block_hash_1"))
+	assert.Error(t, lsi.SignVote(fakeSigner, "test-chain", vote))
+	assert.Nil(t, vote.Signature)
+	assert.Equal(t, int64(0), lsi.Height)
+}
+
+func TestLoadMissingLastSignedInfo(t *testing.T) {
+	_, err := LoadLastSignedInfo("/non_existing_dir/last_signed_info.json")
+	assert.Error(t, err)
+}
@@ -0,0 +1,16 @@
+package events
+
+import (
+	"time"
+
+	"github.com/gallactic/gallactic/common/binary"
+)
+
+// Block is published when a block is committed
+type Block struct {
+	Height  int64           `json:"height"`
+	Hash    binary.HexBytes `json:"hash"`
+	Time    time.Time       `json:"time"`
+	NumTxs  int64           `json:"numTxs"`
+	AppHash binary.HexBytes `json:"appHash"`
+}
@@ -3,18 +3,50 @@ package events
 import (
 	"crypto/rand"
 	"fmt"
+	"strings"
 
+	"github.com/gallactic/gallactic/crypto"
 	tmPubSub "github.com/tendermint/tendermint/libs/pubsub"
 	tmQuery "github.com/tendermint/tendermint/libs/pubsub/query"
 	hex "github.com/tmthrgd/go-hex"
 )
 
+const (
+	typeKey        = "gallactic.events.type"
+	txHashKey      = "gallactic.events.tx.hash"
+	txAddressesKey = "gallactic.events.tx.addresses"
+
+	typeTx    = "tx"
+	typeBlock = "block"
+)
+
 func QueryForTx(txHash []byte) *tmQuery.Query {
-	return tmQuery.MustParse(fmt.Sprintf("gallactic.events.tx.hash='%X'", txHash))
+	return tmQuery.MustParse(fmt.Sprintf("%s='%X'", txHashKey, txHash))
+}
+
+// QueryForAccountTxs matches the receipts of the transactions which are signed or received by the address
+func QueryForAccountTxs(addr crypto.Address) *tmQuery.Query {
+	return tmQuery.MustParse(fmt.Sprintf("%s CONTAINS '%s'", txAddressesKey, addr.String()))
+}
+
+func QueryForNewBlock() *tmQuery.Query {
+	return tmQuery.MustParse(fmt.Sprintf("%s='%s'", typeKey, typeBlock))
+}
+
+func TagsForTx(txHash []byte, addrs []crypto.Address) tmPubSub.TagMap {
+	strs := make([]string, len(addrs))
+	for i, addr := range addrs {
+		strs[i] = addr.String()
+	}
+	return tmPubSub.NewTagMap(map[string]string{
+		typeKey:        typeTx,
+		txHashKey:      fmt.Sprintf("%X", txHash),
+		txAddressesKey: strings.Join(strs, " "),
+	})
 }
 
-func TagsForTx(txHash []byte) tmPubSub.TagMap {
-	return tmPubSub.NewTagMap(map[string]string{"gallactic.events.tx.hash": fmt.Sprintf("%X", txHash)})
+func TagsForBlock() tmPubSub.TagMap {
+	return tmPubSub.NewTagMap(map[string]string{typeKey: typeBlock})
 }
 
 func GenSubID() string {
@@ -0,0 +1,28 @@
+package events
+
+import (
+	"context"
+
+	tmPubSub "github.com/tendermint/tendermint/libs/pubsub"
+)
+
+// SubscribeFunc subscribes to the query and calls handle with the messages in a goroutine.
+//
+// The event bus delivers each message to the subscribers one by one and waits until the channel of the subscriber
+// receives it. The unsubscriptions are handled in the same loop. So a subscriber which stops reading its channel
+// blocks the event bus, the commit of the blocks which publishes the events, and its own unsubscription.
+// SubscribeFunc reads the channel until the event bus closes it on unsubscription. handle should never block.
+func SubscribeFunc(ctx context.Context, eventBus EventBus, subscriber string, query tmPubSub.Query,
+	handle func(msg interface{})) error {
+
+	out := make(chan interface{}, 1)
+	if err := eventBus.Subscribe(ctx, subscriber, query, out); err != nil {
+		return err
+	}
+	go func() {
+		for msg := range out {
+			handle(msg)
+		}
+	}()
+	return nil
+}
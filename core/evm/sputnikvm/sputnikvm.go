@@ -7,14 +7,16 @@ import (
 	ETCCommon "github.com/ethereumproject/go-ethereum/common"
 	"github.com/gallactic/gallactic/core/account"
 	"github.com/gallactic/gallactic/core/evm"
+	"github.com/gallactic/gallactic/core/logging"
 	"github.com/gallactic/sputnikvm-ffi/go/sputnikvm"
-	log "github.com/inconshreveable/log15"
 
 	tmRPC "github.com/tendermint/tendermint/rpc/core"
 )
 
+var logger = logging.New(logging.ModuleEVM)
+
 func Execute(adapter Adapter) Output {
-	log.Debug("SputnikVM called", "tx_hash", adapter.GetTxHash())
+	logger.Debug("SputnikVM called", "tx_hash", adapter.GetTxHash())
 
 	var out Output
 
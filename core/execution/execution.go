@@ -4,19 +4,24 @@ import (
 	"fmt"
 	"runtime/debug"
 	"sync"
+	"time"
 
 	e "github.com/gallactic/gallactic/errors"
 
 	"github.com/gallactic/gallactic/core/blockchain"
 	"github.com/gallactic/gallactic/core/events"
 	"github.com/gallactic/gallactic/core/execution/executors"
+	"github.com/gallactic/gallactic/core/indexer"
+	"github.com/gallactic/gallactic/core/logging"
+	"github.com/gallactic/gallactic/core/metrics"
 	"github.com/gallactic/gallactic/core/state"
+	"github.com/gallactic/gallactic/crypto"
 	"github.com/gallactic/gallactic/txs"
 	"github.com/gallactic/gallactic/txs/tx"
-
-	log "github.com/inconshreveable/log15"
 )
 
+var logger = logging.New(logging.ModuleExecution)
+
 type Executor interface {
 	Execute(txEnv *txs.Envelope, txRec *txs.Receipt) error
 }
@@ -32,6 +37,14 @@ type BatchExecutor interface {
 	Reset() error
 }
 
+// BatchChecker executes the transactions against the check cache (mempool)
+type BatchChecker interface {
+	BatchExecutor
+
+	// MempoolAccounts returns a read-only view of the check cache
+	MempoolAccounts() MempoolAccounts
+}
+
 // Executes transactions
 type BatchCommitter interface {
 	BatchExecutor
@@ -45,32 +58,40 @@ type BatchCommitter interface {
 
 type executor struct {
 	sync.RWMutex
+	// txLock serializes the execution of the transactions with the reads of MempoolAccounts
+	txLock          sync.Mutex
 	bc              *blockchain.Blockchain
 	cache           *state.Cache
 	eventBus        events.EventBus
+	txIndexer       *indexer.TxIndexer
 	txExecutors     map[tx.Type]Executor
 	accumulatedFees uint64
 	name            string
+	committing      bool
 }
 
-var _ BatchExecutor = (*executor)(nil)
+var _ BatchChecker = (*executor)(nil)
 
 // Wraps a cache of what is variously known as the 'check cache' and 'mempool'
-func NewBatchChecker(bc *blockchain.Blockchain) BatchExecutor {
-	return newExecutor("TxCheck", false, bc, events.NewNopeEventBus())
+func NewBatchChecker(bc *blockchain.Blockchain) BatchChecker {
+	return newExecutor("TxCheck", false, bc, events.NewNopeEventBus(), nil)
 }
 
-func NewBatchCommitter(bc *blockchain.Blockchain, eventBus events.EventBus) BatchCommitter {
-	return newExecutor("TxCommit", true, bc, eventBus)
+// NewBatchCommitter creates the executor of the blocks. The committed transactions are
+// added to the indexer if it is not nil, which is written with the block by the blockchain.
+func NewBatchCommitter(bc *blockchain.Blockchain, eventBus events.EventBus, txIndexer *indexer.TxIndexer) BatchCommitter {
+	return newExecutor("TxCommit", true, bc, eventBus, txIndexer)
 }
 
-func newExecutor(name string, committing bool, bc *blockchain.Blockchain, eventBus events.EventBus) *executor {
+func newExecutor(name string, committing bool, bc *blockchain.Blockchain, eventBus events.EventBus, txIndexer *indexer.TxIndexer) *executor {
 
 	exe := &executor{
-		name:     name,
-		bc:       bc,
-		eventBus: eventBus,
-		cache:    state.NewCache(bc.State()),
+		name:       name,
+		committing: committing,
+		bc:         bc,
+		eventBus:   eventBus,
+		txIndexer:  txIndexer,
+		cache:      state.NewCache(bc.State()),
 	}
 
 	exe.txExecutors = map[tx.Type]Executor{
@@ -110,6 +131,22 @@ func newExecutor(name string, committing bool, bc *blockchain.Blockchain, eventB
 func (exe *executor) Execute(txEnv *txs.Envelope, txRec *txs.Receipt) error {
 	var err error
 
+	exe.txLock.Lock()
+	defer exe.txLock.Unlock()
+
+	if exe.committing {
+		start := time.Now()
+		defer func() {
+			status := "ok"
+			if txRec.Status == txs.Failed {
+				status = "failed"
+			} else if err != nil {
+				status = "rejected"
+			}
+			metrics.TxExecuted(txEnv.Tx.Type().String(), status, time.Since(start))
+		}()
+	}
+
 	defer func() {
 		/* TODO:::: better crash
 		if r := recover(); r != nil {
@@ -119,22 +156,16 @@ func (exe *executor) Execute(txEnv *txs.Envelope, txRec *txs.Receipt) error {
 		*/
 	}()
 
-	// Verify transaction signature against inputs
-	if err = txEnv.Verify(); err != nil {
-		return err
-	}
-
-	if err = txEnv.Tx.EnsureValid(); err != nil {
+	executor, err := exe.txExecutor(txEnv)
+	if err != nil {
+		if exe.committing {
+			exe.fireRejected(txRec)
+		}
 		return err
 	}
 
-	executor, ok := exe.txExecutors[txEnv.Tx.Type()]
-	if !ok {
-		return e.Errorf(e.ErrInvalidTxType, "unknown transaction type: %v", txEnv.Tx.Type())
-	}
-
 	if err = executor.Execute(txEnv, txRec); err != nil {
-		log.Error("Transaction execution failed",
+		logger.Error("Transaction execution failed",
 			"error", err,
 			"executor", exe.name,
 			"env", txEnv.String())
@@ -142,11 +173,39 @@ func (exe *executor) Execute(txEnv *txs.Envelope, txRec *txs.Receipt) error {
 		txRec.Status = txs.Failed
 	}
 
-	exe.fireEvents(txRec)
+	addrs := txAddresses(txEnv, txRec)
+	exe.fireEvents(txRec, addrs)
+	if exe.txIndexer != nil {
+		exe.txIndexer.Add(txRec, addrs)
+	}
 
 	return err
 }
 
+// txExecutor returns the executor of the transaction, if the transaction can be executed in the next block
+func (exe *executor) txExecutor(txEnv *txs.Envelope) (Executor, error) {
+	// Verify transaction signature against inputs
+	if err := txEnv.Verify(); err != nil {
+		return nil, err
+	}
+
+	if err := txEnv.Tx.EnsureValid(); err != nil {
+		return nil, err
+	}
+
+	// The transaction would be executed in the next block. The mempool rechecks its transactions
+	// after each commit, so the expired ones are evicted before they are proposed again.
+	if height := exe.bc.LastBlockHeight() + 1; txEnv.Expired(height) {
+		return nil, e.Errorf(e.ErrTxExpired, "transaction is valid until height %v, but the height is %v", txEnv.ValidUntilHeight, height)
+	}
+
+	executor, ok := exe.txExecutors[txEnv.Tx.Type()]
+	if !ok {
+		return nil, e.Errorf(e.ErrInvalidTxType, "unknown transaction type: %v", txEnv.Tx.Type())
+	}
+	return executor, nil
+}
+
 func (exe *executor) Commit() (err error) {
 	// The write lock to the executor is controlled by the caller (e.g. abci.App) so we do not acquire it here to avoid
 	// deadlock
@@ -163,6 +222,9 @@ func (exe *executor) Reset() error {
 	exe.accumulatedFees = 0
 	// As with Commit() we do not take the write lock here
 	exe.cache.Reset()
+	if exe.txIndexer != nil {
+		exe.txIndexer.Reset()
+	}
 	return nil
 }
 
@@ -170,9 +232,29 @@ func (exe *executor) Fees() uint64 {
 	return exe.accumulatedFees
 }
 
-func (exe *executor) fireEvents(receipt *txs.Receipt) {
-	err := exe.eventBus.Publish(receipt, events.TagsForTx(receipt.Hash))
+// txAddresses returns the addresses touched by the transaction, including the created contract
+func txAddresses(txEnv *txs.Envelope, receipt *txs.Receipt) []crypto.Address {
+	addrs := tx.Addresses(txEnv.Tx)
+	if receipt.ContractAddress != nil {
+		addrs = append(addrs, *receipt.ContractAddress)
+	}
+	return addrs
+}
+
+// fireRejected publishes a failed receipt for a transaction of the block which is rejected before the execution,
+// so the subscribers of the transaction don't wait for it. The transaction may not be signed by its signers,
+// so the receipt is published for its hash only.
+func (exe *executor) fireRejected(txRec *txs.Receipt) {
+	rejected := *txRec
+	rejected.Status = txs.Failed
+	if err := exe.eventBus.Publish(&rejected, events.TagsForTx(rejected.Hash, nil)); err != nil {
+		logger.Error("Error publishing Event", "error", err, "tx_hash", rejected.Hash)
+	}
+}
+
+func (exe *executor) fireEvents(receipt *txs.Receipt, addrs []crypto.Address) {
+	err := exe.eventBus.Publish(receipt, events.TagsForTx(receipt.Hash, addrs))
 	if err != nil {
-		log.Error("Error publishing Event", "error", err, "tx_hash", receipt.Hash)
+		logger.Error("Error publishing Event", "error", err, "tx_hash", receipt.Hash)
 	}
 }
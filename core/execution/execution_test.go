@@ -0,0 +1,112 @@
+package execution
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/account/permission"
+	"github.com/gallactic/gallactic/core/blockchain"
+	"github.com/gallactic/gallactic/core/events"
+	"github.com/gallactic/gallactic/core/proposal"
+	"github.com/gallactic/gallactic/core/validator"
+	"github.com/gallactic/gallactic/crypto"
+	e "github.com/gallactic/gallactic/errors"
+	"github.com/gallactic/gallactic/keystore/key"
+	"github.com/gallactic/gallactic/txs"
+	"github.com/gallactic/gallactic/txs/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func TestExpiredTx(t *testing.T) {
+	k := key.GenAccountKey()
+	signer := crypto.NewAccountSigner(k.PrivateKey())
+	acc, _ := account.NewAccount(k.Address())
+	acc.AddToBalance(1000)
+	val, _ := validator.NewValidator(key.GenValidatorKey().PublicKey(), 0)
+	gAcc, _ := account.NewAccount(crypto.GlobalAddress)
+	gAcc.SetPermissions(permission.AllPermissions)
+	gen := proposal.MakeGenesis("expiry", time.Now().UTC().Truncate(0), gAcc, []*account.Account{acc}, nil, []*validator.Validator{val})
+	bc, err := blockchain.LoadOrNewBlockchain(dbm.NewMemDB(), gen, nil)
+	require.NoError(t, err)
+
+	checker := NewBatchChecker(bc)
+	committer := NewBatchCommitter(bc, events.NewNopeEventBus(), nil)
+
+	makeEnv := func(seq, validUntil uint64) *txs.Envelope {
+		sendTx, err := tx.NewSendTx(signer.Address(), key.GenAccountKey().Address(), seq, 100, 1)
+		require.NoError(t, err)
+		txEnv := txs.Enclose(gen.ChainID(), sendTx)
+		txEnv.ValidUntilHeight = validUntil
+		require.NoError(t, txEnv.Sign(signer))
+		return txEnv
+	}
+
+	// The next block is the first one
+	env1 := makeEnv(1, 1)
+	require.NoError(t, checker.Execute(env1, env1.GenerateReceipt()))
+	require.NoError(t, committer.Execute(env1, env1.GenerateReceipt()))
+	_, err = bc.CommitBlock(time.Now(), []byte{1})
+	require.NoError(t, err)
+
+	// The next block is the second one, the transaction is expired
+	env2 := makeEnv(2, 1)
+	err = checker.Execute(env2, env2.GenerateReceipt())
+	require.Error(t, err)
+	assert.Equal(t, e.ErrTxExpired, e.Code(err))
+	err = committer.Execute(env2, env2.GenerateReceipt())
+	require.Error(t, err)
+	assert.Equal(t, e.ErrTxExpired, e.Code(err))
+
+	env3 := makeEnv(2, 2)
+	require.NoError(t, checker.Execute(env3, env3.GenerateReceipt()))
+	require.NoError(t, committer.Execute(env3, env3.GenerateReceipt()))
+
+	// Transactions without the expiry never expire
+	env4 := makeEnv(3, 0)
+	require.NoError(t, checker.Execute(env4, env4.GenerateReceipt()))
+}
+
+func TestRejectedTxReceipt(t *testing.T) {
+	k := key.GenAccountKey()
+	signer := crypto.NewAccountSigner(k.PrivateKey())
+	acc, _ := account.NewAccount(k.Address())
+	acc.AddToBalance(1000)
+	val, _ := validator.NewValidator(key.GenValidatorKey().PublicKey(), 0)
+	gAcc, _ := account.NewAccount(crypto.GlobalAddress)
+	gAcc.SetPermissions(permission.AllPermissions)
+	gen := proposal.MakeGenesis("rejected", time.Now().UTC().Truncate(0), gAcc, []*account.Account{acc}, nil, []*validator.Validator{val})
+	bc, err := blockchain.LoadOrNewBlockchain(dbm.NewMemDB(), gen, nil)
+	require.NoError(t, err)
+
+	eventBus := events.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	defer eventBus.Stop()
+	checker := NewBatchChecker(bc)
+	committer := NewBatchCommitter(bc, eventBus, nil)
+
+	// A transaction of the block which is not signed
+	sendTx, err := tx.NewSendTx(signer.Address(), key.GenAccountKey().Address(), 1, 100, 1)
+	require.NoError(t, err)
+	txEnv := txs.Enclose(gen.ChainID(), sendTx)
+	out := make(chan *txs.Receipt, 1)
+	require.NoError(t, events.SubscribeFunc(context.Background(), eventBus, "test", events.QueryForTx(txEnv.Hash()), func(msg interface{}) {
+		out <- msg.(*txs.Receipt)
+	}))
+
+	// The checker doesn't publish the receipts
+	assert.Error(t, checker.Execute(txEnv, txEnv.GenerateReceipt()))
+	txRec := txEnv.GenerateReceipt()
+	txRec.Height = 1
+	assert.Error(t, committer.Execute(txEnv, txRec))
+	select {
+	case receipt := <-out:
+		assert.Equal(t, txs.Failed, receipt.Status)
+		assert.Equal(t, int64(1), receipt.Height)
+	case <-time.After(time.Second):
+		t.Fatal("no receipt is published for the rejected transaction")
+	}
+}
@@ -7,13 +7,15 @@ import (
 	"github.com/gallactic/gallactic/core/account/permission"
 	"github.com/gallactic/gallactic/core/blockchain"
 	"github.com/gallactic/gallactic/core/evm/sputnikvm"
+	"github.com/gallactic/gallactic/core/logging"
 	"github.com/gallactic/gallactic/core/state"
 	e "github.com/gallactic/gallactic/errors"
 	"github.com/gallactic/gallactic/txs"
 	"github.com/gallactic/gallactic/txs/tx"
-	log "github.com/inconshreveable/log15"
 )
 
+var logger = logging.New(logging.ModuleExecution)
+
 type CallContext struct {
 	Committing bool
 	BC         *blockchain.Blockchain
@@ -41,7 +43,7 @@ func (ctx *CallContext) Execute(txEnv *txs.Envelope, txRec *txs.Receipt) error {
 		// sputnik vm will create the account and returns the code
 		callee = nil
 
-		log.Debug("Creating new contract", "init_code", tx.Data())
+		logger.Debug("Creating new contract", "init_code", tx.Data())
 	} else {
 		callee, _ = ctx.Cache.GetAccount(tx.Callee().Address)
 		if callee == nil {
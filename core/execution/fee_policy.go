@@ -0,0 +1,45 @@
+package execution
+
+import (
+	e "github.com/gallactic/gallactic/errors"
+	"github.com/gallactic/gallactic/txs"
+	"github.com/gallactic/gallactic/txs/tx"
+)
+
+// FeePolicy is the minimum fee which the node accepts into its mempool. It's the node's local policy,
+// so it's checked in CheckTx and never in DeliverTx. The zero value accepts all the transactions.
+type FeePolicy struct {
+	MinFee        uint64
+	MinFeePerByte uint64
+	// MinFeePerGas applies to the call transactions, on top of MinFeePerByte
+	MinFeePerGas uint64
+}
+
+// Check returns ErrInsufficientFee if the fee of the transaction is lower than the policy.
+// Sortition transactions are sent by the validators with the fee of the chain, so they are always accepted.
+func (p FeePolicy) Check(txEnv *txs.Envelope) error {
+	if txEnv.Type == tx.TypeSortition {
+		return nil
+	}
+	fee := txEnv.Tx.Fee()
+	if fee < p.MinFee {
+		return e.Errorf(e.ErrInsufficientFee, "fee is %v, minimum fee is %v", fee, p.MinFee)
+	}
+	// The rates are compared by division, the gas limit is set by the sender and the product may overflow
+	if p.MinFeePerByte > 0 {
+		size := uint64(txEnv.Size())
+		if fee/size < p.MinFeePerByte {
+			return e.Errorf(e.ErrInsufficientFee, "fee is %v for %v bytes, minimum fee per byte is %v",
+				fee, size, p.MinFeePerByte)
+		}
+	}
+	if p.MinFeePerGas > 0 {
+		if callTx, ok := txEnv.Tx.(*tx.CallTx); ok {
+			if gas := callTx.GasLimit(); gas > 0 && fee/gas < p.MinFeePerGas {
+				return e.Errorf(e.ErrInsufficientFee, "fee is %v for %v gas, minimum fee per gas is %v",
+					fee, gas, p.MinFeePerGas)
+			}
+		}
+	}
+	return nil
+}
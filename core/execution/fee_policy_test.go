@@ -0,0 +1,65 @@
+package execution
+
+import (
+	"testing"
+
+	e "github.com/gallactic/gallactic/errors"
+	"github.com/gallactic/gallactic/keystore/key"
+	"github.com/gallactic/gallactic/txs"
+	"github.com/gallactic/gallactic/txs/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeePolicy(t *testing.T) {
+	from := key.GenAccountKey().Address()
+	to := key.GenAccountKey().Address()
+	enclose := func(t tx.Tx) *txs.Envelope {
+		return txs.Enclose("fee-policy", t)
+	}
+	sendTx, err := tx.NewSendTx(from, to, 1, 100, 10000)
+	require.NoError(t, err)
+	send := enclose(sendTx)
+	callTx, err := tx.NewCallTx(from, to, 1, []byte{1, 2, 3}, 1000, 100, 5000)
+	require.NoError(t, err)
+	call := enclose(callTx)
+	sortitionTx, err := tx.NewSortitionTx(key.GenValidatorKey().Address(), 1, 1, 0, 1, []byte{1})
+	require.NoError(t, err)
+	sortition := enclose(sortitionTx)
+
+	// The zero value accepts all the transactions
+	assert.NoError(t, FeePolicy{}.Check(send))
+	assert.NoError(t, FeePolicy{}.Check(sortition))
+
+	p := FeePolicy{MinFee: 10000}
+	assert.NoError(t, p.Check(send))
+	p.MinFee = 10001
+	err = p.Check(send)
+	require.Error(t, err)
+	assert.Equal(t, e.ErrInsufficientFee, e.Code(err))
+
+	// Sortition transactions are always accepted
+	assert.NoError(t, p.Check(sortition))
+
+	perByte := 10000 / uint64(send.Size())
+	p = FeePolicy{MinFeePerByte: perByte}
+	assert.NoError(t, p.Check(send))
+	p.MinFeePerByte = perByte + 1
+	err = p.Check(send)
+	require.Error(t, err)
+	assert.Equal(t, e.ErrInsufficientFee, e.Code(err))
+
+	// The fee per gas applies to the call transactions only
+	p = FeePolicy{MinFeePerGas: 5}
+	assert.NoError(t, p.Check(call))
+	assert.NoError(t, p.Check(send))
+	p.MinFeePerGas = 6
+	err = p.Check(call)
+	require.Error(t, err)
+	assert.Equal(t, e.ErrInsufficientFee, e.Code(err))
+
+	// The product of a huge gas limit doesn't overflow
+	callTx, err = tx.NewCallTx(from, to, 1, nil, 1<<62, 100, 5000)
+	require.NoError(t, err)
+	assert.Error(t, p.Check(enclose(callTx)))
+}
@@ -0,0 +1,93 @@
+package execution
+
+import (
+	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/validator"
+	"github.com/gallactic/gallactic/crypto"
+)
+
+// MempoolAccounts is a read-only view of the check cache. The accounts and the validators
+// include the changes of the transactions in the mempool, which are not committed yet.
+type MempoolAccounts interface {
+	GetAccount(addr crypto.Address) (*account.Account, error)
+	GetValidator(addr crypto.Address) (*validator.Validator, error)
+	// Sequence returns the sequence of the account or the validator.
+	// The next transaction of the signer should have the next sequence.
+	Sequence(addr crypto.Address) (uint64, error)
+}
+
+type mempoolAccounts struct {
+	exe *executor
+}
+
+var _ MempoolAccounts = (*mempoolAccounts)(nil)
+
+func (exe *executor) MempoolAccounts() MempoolAccounts {
+	return &mempoolAccounts{exe: exe}
+}
+
+// read runs fn while the check cache is consistent. The checker is locked by abci.App during the commit and
+// the recheck of the mempool, and txLock excludes the transactions which are being checked.
+func (ma *mempoolAccounts) read(fn func() error) error {
+	ma.exe.RLock()
+	defer ma.exe.RUnlock()
+	ma.exe.txLock.Lock()
+	defer ma.exe.txLock.Unlock()
+
+	return fn()
+}
+
+// GetAccount returns a copy of the account, so it is not changed by the checker
+func (ma *mempoolAccounts) GetAccount(addr crypto.Address) (acc *account.Account, err error) {
+	err = ma.read(func() error {
+		cached, err := ma.exe.cache.GetAccount(addr)
+		if err != nil {
+			return err
+		}
+		bs, err := cached.Encode()
+		if err != nil {
+			return err
+		}
+		acc, err = account.AccountFromBytes(bs)
+		return err
+	})
+	return acc, err
+}
+
+// GetValidator returns a copy of the validator, so it is not changed by the checker
+func (ma *mempoolAccounts) GetValidator(addr crypto.Address) (val *validator.Validator, err error) {
+	err = ma.read(func() error {
+		cached, err := ma.exe.cache.GetValidator(addr)
+		if err != nil {
+			return err
+		}
+		bs, err := cached.Encode()
+		if err != nil {
+			return err
+		}
+		val, err = validator.ValidatorFromBytes(bs)
+		return err
+	})
+	return val, err
+}
+
+func (ma *mempoolAccounts) Sequence(addr crypto.Address) (seq uint64, err error) {
+	err = ma.read(func() error {
+		if addr.IsValidatorAddress() {
+			val, err := ma.exe.cache.GetValidator(addr)
+			if err != nil {
+				return err
+			}
+			seq = val.Sequence()
+			return nil
+		}
+
+		acc, err := ma.exe.cache.GetAccount(addr)
+		if err != nil {
+			return err
+		}
+		seq = acc.Sequence()
+		return nil
+	})
+	return seq, err
+}
@@ -0,0 +1,86 @@
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/account/permission"
+	"github.com/gallactic/gallactic/core/blockchain"
+	"github.com/gallactic/gallactic/core/proposal"
+	"github.com/gallactic/gallactic/core/validator"
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/keystore/key"
+	"github.com/gallactic/gallactic/txs"
+	"github.com/gallactic/gallactic/txs/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func TestMempoolAccounts(t *testing.T) {
+	k := key.GenAccountKey()
+	signer := crypto.NewAccountSigner(k.PrivateKey())
+	acc, _ := account.NewAccount(k.Address())
+	acc.AddToBalance(1000)
+	valKey := key.GenValidatorKey()
+	val, _ := validator.NewValidator(valKey.PublicKey(), 0)
+	gAcc, _ := account.NewAccount(crypto.GlobalAddress)
+	gAcc.SetPermissions(permission.AllPermissions)
+	gen := proposal.MakeGenesis("mempool", time.Now().UTC().Truncate(0), gAcc, []*account.Account{acc}, nil, []*validator.Validator{val})
+	bc, err := blockchain.LoadOrNewBlockchain(dbm.NewMemDB(), gen, nil)
+	require.NoError(t, err)
+
+	checker := NewBatchChecker(bc)
+	view := checker.MempoolAccounts()
+
+	sendTx, err := tx.NewSendTx(signer.Address(), key.GenAccountKey().Address(), 1, 100, 1)
+	require.NoError(t, err)
+	txEnv := txs.Enclose(gen.ChainID(), sendTx)
+	require.NoError(t, txEnv.Sign(signer))
+	require.NoError(t, checker.Execute(txEnv, txEnv.GenerateReceipt()))
+
+	// The pending changes are visible, but not committed
+	pending, err := view.GetAccount(signer.Address())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), pending.Sequence())
+	assert.Equal(t, uint64(899), pending.Balance())
+	seq, err := view.Sequence(signer.Address())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), seq)
+	committed, err := bc.State().GetAccount(signer.Address())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), committed.Sequence())
+
+	// The view returns copies
+	pending.AddToBalance(1000)
+	pending, err = view.GetAccount(signer.Address())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(899), pending.Balance())
+
+	pendingVal, err := view.GetValidator(valKey.Address())
+	require.NoError(t, err)
+	assert.Equal(t, val.Stake(), pendingVal.Stake())
+	seq, err = view.Sequence(valKey.Address())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), seq)
+
+	_, err = view.GetAccount(key.GenAccountKey().Address())
+	assert.Error(t, err)
+
+	// The reads wait while the checker is locked, like during the commit and the recheck
+	checker.Lock()
+	done := make(chan uint64)
+	go func() {
+		seq, _ := view.Sequence(signer.Address())
+		done <- seq
+	}()
+	select {
+	case <-done:
+		t.Fatal("the view is read while the checker is locked")
+	case <-time.After(50 * time.Millisecond):
+	}
+	require.NoError(t, checker.Reset())
+	checker.Unlock()
+	assert.Equal(t, uint64(0), <-done)
+}
@@ -4,55 +4,103 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
+	"github.com/gallactic/gallactic/common/binary"
 	"github.com/gallactic/gallactic/core/consensus/tendermint/codes"
 	"github.com/gallactic/gallactic/core/events"
+	"github.com/gallactic/gallactic/crypto"
+	e "github.com/gallactic/gallactic/errors"
 	"github.com/gallactic/gallactic/txs"
+	"github.com/gallactic/gallactic/txs/tx"
 
-	log "github.com/inconshreveable/log15"
 	abciTypes "github.com/tendermint/tendermint/abci/types"
 	tmTypes "github.com/tendermint/tendermint/types"
 )
 
 const (
+	// blockingTimeout is the default time which the transactor waits for a transaction to be committed
 	blockingTimeout = 100 * time.Second
+	// maxResequences is the number of the times a rejected transaction is re-sequenced and submitted again
+	maxResequences = 3
 )
 
 // Transactor is the controller/middleware for the v0 RPC
 type Transactor struct {
+	chainID         string
 	broadcastTxFunc func(tx tmTypes.Tx, cb func(*abciTypes.Response)) error
+	mempoolAccounts MempoolAccounts
 	eventBus        events.EventBus
+	signers         map[crypto.Address]*queuedSigner
+	commitTimeout   time.Duration
 }
 
-func NewTransactor(broadcastTxFunc func(tx tmTypes.Tx, cb func(*abciTypes.Response)) error,
-	eventBus events.EventBus) *Transactor {
+// TxCommitResult is the result of BroadcastTxCommit
+type TxCommitResult struct {
+	// CheckTx is the receipt of the transaction when it is added to the mempool
+	CheckTx *txs.Receipt `json:"checkTx"`
+	// DeliverTx is the receipt of the transaction when it is executed in the block
+	DeliverTx *txs.Receipt    `json:"deliverTx"`
+	Height    int64           `json:"height"`
+	BlockHash binary.HexBytes `json:"blockHash"`
+}
+
+// queuedSigner is a node-managed key. The submissions of the signer are queued,
+// so its transactions get consecutive sequences.
+type queuedSigner struct {
+	sync.Mutex
+	signer crypto.Signer
+}
+
+func NewTransactor(chainID string, broadcastTxFunc func(tx tmTypes.Tx, cb func(*abciTypes.Response)) error,
+	mempoolAccounts MempoolAccounts, eventBus events.EventBus) *Transactor {
 
 	return &Transactor{
+		chainID:         chainID,
 		broadcastTxFunc: broadcastTxFunc,
+		mempoolAccounts: mempoolAccounts,
 		eventBus:        eventBus,
+		signers:         make(map[crypto.Address]*queuedSigner),
+		commitTimeout:   blockingTimeout,
+	}
+}
+
+// SetCommitTimeout sets the time which BroadcastTxSync and BroadcastTxCommit wait for the transaction to be committed
+func (trans *Transactor) SetCommitTimeout(timeout time.Duration) {
+	trans.commitTimeout = timeout
+}
+
+// SetSigners sets the node-managed keys which BroadcastTxAuto signs the transactions with.
+// It should be called before the transactor is used.
+func (trans *Transactor) SetSigners(signers []crypto.Signer) {
+	trans.signers = make(map[crypto.Address]*queuedSigner)
+	for _, signer := range signers {
+		trans.signers[signer.Address()] = &queuedSigner{signer: signer}
 	}
 }
 
 func (trans *Transactor) BroadcastTxSync(txEnv *txs.Envelope) (*txs.Receipt, error) {
-	log.Info("Broadcasting Tx Sync",
+	logger.Info("Broadcasting Tx Sync",
 		"tx_hash", txEnv.Hash(),
 		"tx", txEnv.String())
 
-	ctx, cancel := context.WithTimeout(context.Background(), blockingTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), trans.commitTimeout)
 	defer cancel()
 
 	// Subscribe before submitting to mempool
-	txHash := txEnv.Hash()
 	subID := events.GenSubID()
-	out := make(chan interface{}, 1)
-	q := events.QueryForTx(txHash)
-
-	if err := trans.eventBus.Subscribe(ctx, subID, q, out); err != nil {
-		// We do not want to hold the lock with a defer so we must
+	out := make(chan *txs.Receipt, 1)
+	// The context may be done already
+	defer trans.eventBus.UnsubscribeAll(context.Background(), subID)
+	if err := events.SubscribeFunc(ctx, trans.eventBus, subID, events.QueryForTx(txEnv.Hash()), func(msg interface{}) {
+		select {
+		case out <- msg.(*txs.Receipt):
+		default:
+		}
+	}); err != nil {
 		return nil, err
 	}
-	defer trans.eventBus.UnsubscribeAll(ctx, subID)
 
 	receipt, err := trans.broadcastTxRaw(txEnv)
 	if err != nil {
@@ -64,20 +112,147 @@ func (trans *Transactor) BroadcastTxSync(txEnv *txs.Envelope) (*txs.Receipt, err
 	case <-ctx.Done():
 		return receipt, ctx.Err()
 
-	case msg := <-out:
-		receipt2 := msg.(*txs.Receipt)
+	case receipt2 := <-out:
 		return receipt2, nil
 	}
 }
 
+// BroadcastTxCommit broadcasts the transaction and waits until the block which includes it is committed. Committed
+// blocks are final. The mempool rejections are ErrTxRejected errors, or ErrInsufficientFee if the fee is lower than
+// the node's minimum fee, and ErrTimeOut is returned if the transaction is not committed in the commit timeout.
+func (trans *Transactor) BroadcastTxCommit(txEnv *txs.Envelope) (*TxCommitResult, error) {
+	logger.Info("Broadcasting Tx Commit",
+		"tx_hash", txEnv.Hash(),
+		"tx", txEnv.String())
+
+	ctx, cancel := context.WithTimeout(context.Background(), trans.commitTimeout)
+	defer cancel()
+
+	// Subscribe before submitting to mempool. The checker doesn't publish the receipts, so the receipt of the
+	// transaction comes from the committer. The receipt and the blocks are handled in the goroutines of their
+	// subscriptions, so the block of the transaction may be handled before its receipt.
+	var lk sync.Mutex
+	var deliverTx *txs.Receipt
+	blockHashes := make(map[int64]binary.HexBytes)
+	committed := make(chan *TxCommitResult, 1)
+	checkCommitted := func() {
+		if deliverTx == nil {
+			return
+		}
+		if hash, ok := blockHashes[deliverTx.Height]; ok {
+			select {
+			case committed <- &TxCommitResult{DeliverTx: deliverTx, Height: deliverTx.Height, BlockHash: hash}:
+			default:
+			}
+		}
+	}
+
+	subID := events.GenSubID()
+	// The context may be done already
+	defer trans.eventBus.UnsubscribeAll(context.Background(), subID)
+	if err := events.SubscribeFunc(ctx, trans.eventBus, subID, events.QueryForTx(txEnv.Hash()), func(msg interface{}) {
+		lk.Lock()
+		defer lk.Unlock()
+		deliverTx = msg.(*txs.Receipt)
+		checkCommitted()
+	}); err != nil {
+		return nil, err
+	}
+	if err := events.SubscribeFunc(ctx, trans.eventBus, subID, events.QueryForNewBlock(), func(msg interface{}) {
+		lk.Lock()
+		defer lk.Unlock()
+		block := msg.(*events.Block)
+		blockHashes[block.Height] = block.Hash
+		checkCommitted()
+	}); err != nil {
+		return nil, err
+	}
+
+	checkTx, err := trans.broadcastTxRaw(txEnv)
+	if err != nil {
+		if e.Code(err) == e.ErrInsufficientFee {
+			return nil, err
+		}
+		return nil, e.Errorf(e.ErrTxRejected, "%v", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, e.Errorf(e.ErrTimeOut, "transaction %X is not committed in %v", txEnv.Hash(), trans.commitTimeout)
+
+	case result := <-committed:
+		// The transaction expired while it was waiting in the mempool
+		if result.DeliverTx.Status == txs.Failed && txEnv.Expired(uint64(result.Height)) {
+			return nil, e.Errorf(e.ErrTxExpired, "transaction %X is valid until height %v, but it is delivered at height %v",
+				txEnv.Hash(), txEnv.ValidUntilHeight, result.Height)
+		}
+		result.CheckTx = checkTx
+		return result, nil
+	}
+}
+
 func (trans *Transactor) BroadcastTxAsync(txEnv *txs.Envelope) (*txs.Receipt, error) {
-	log.Info("Broadcasting Tx Async",
+	logger.Info("Broadcasting Tx Async",
 		"tx_hash", txEnv.Hash(),
 		"tx", txEnv.String())
 
 	return trans.broadcastTxRaw(txEnv)
 }
 
+// BroadcastTxAuto sets the sequence of the transaction, signs it with the node-managed key of the signer and
+// broadcasts it on the chain of the node. It returns once the transaction is added to the mempool. The sequence is taken from the
+// mempool view of the signer, so the transactions of the signer don't wait for the blocks. If the transaction
+// is rejected because its sequence is taken by another transaction, it is re-sequenced and submitted again.
+// Zero validUntilHeight means the transaction doesn't expire.
+func (trans *Transactor) BroadcastTxAuto(t tx.Tx, validUntilHeight uint64) (*txs.Receipt, error) {
+	signers := t.Signers()
+	if len(signers) != 1 {
+		return nil, e.Errorf(e.ErrInvalidTxType, "transaction should have exactly one signer")
+	}
+	qs, ok := trans.signers[signers[0].Address]
+	if !ok {
+		return nil, e.Errorf(e.ErrInvalidAddress, "the node has no key for %s", signers[0].Address)
+	}
+
+	qs.Lock()
+	defer qs.Unlock()
+
+	for attempt := 0; ; attempt++ {
+		seq, err := trans.mempoolAccounts.Sequence(qs.signer.Address())
+		if err != nil {
+			return nil, err
+		}
+		if err := tx.SetSequence(t, seq+1); err != nil {
+			return nil, err
+		}
+		txEnv := txs.Enclose(trans.chainID, t)
+		txEnv.ValidUntilHeight = validUntilHeight
+		if err := txEnv.Sign(qs.signer); err != nil {
+			return nil, err
+		}
+
+		logger.Info("Broadcasting Tx Auto",
+			"tx_hash", txEnv.Hash(),
+			"tx", txEnv.String())
+
+		receipt, err := trans.broadcastTxRaw(txEnv)
+		if err == nil || attempt >= maxResequences {
+			return receipt, err
+		}
+
+		// Only a transaction which has lost its sequence is submitted again
+		pendingSeq, seqErr := trans.mempoolAccounts.Sequence(qs.signer.Address())
+		if seqErr != nil || pendingSeq == seq {
+			return nil, err
+		}
+		logger.Info("Re-sequencing the rejected transaction",
+			"signer", qs.signer.Address(),
+			"sequence", seq+1,
+			"pending_sequence", pendingSeq,
+			"error", err)
+	}
+}
+
 func (trans *Transactor) broadcastTxRaw(txEnv *txs.Envelope) (*txs.Receipt, error) {
 	txBytes, err := txEnv.Encode()
 	if err != nil {
@@ -105,6 +280,8 @@ func (trans *Transactor) broadcastTxRaw(txEnv *txs.Envelope) (*txs.Receipt, erro
 			return nil, fmt.Errorf("could not deserialise transaction receipt: %s", err)
 		}
 		return receipt, nil
+	case codes.InsufficientFeeCode:
+		return nil, e.Errorf(e.ErrInsufficientFee, "ABCI log: %v", checkTxResponse.Log)
 	default:
 		return nil, fmt.Errorf("error returned by Tendermint in BroadcastTxSync "+
 			"ABCI code: %v, ABCI log: %v", checkTxResponse.Code, checkTxResponse.Log)
@@ -0,0 +1,214 @@
+package execution
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gallactic/gallactic/common/binary"
+	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/consensus/tendermint/codes"
+	"github.com/gallactic/gallactic/core/events"
+	"github.com/gallactic/gallactic/core/validator"
+	"github.com/gallactic/gallactic/crypto"
+	e "github.com/gallactic/gallactic/errors"
+	"github.com/gallactic/gallactic/keystore/key"
+	"github.com/gallactic/gallactic/txs"
+	"github.com/gallactic/gallactic/txs/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// mempool checks the sequences of the transactions like the checker.
+// Only the sequences of the accounts are kept.
+type mempool struct {
+	sync.Mutex
+	sequences map[crypto.Address]uint64
+	// steal takes the next sequence of the signer before the transaction is checked
+	steal int
+}
+
+func (m *mempool) GetAccount(addr crypto.Address) (*account.Account, error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+func (m *mempool) GetValidator(addr crypto.Address) (*validator.Validator, error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+func (m *mempool) Sequence(addr crypto.Address) (uint64, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.sequences[addr], nil
+}
+
+func (m *mempool) checkTx(bs tmTypes.Tx, cb func(*abciTypes.Response)) error {
+	m.Lock()
+	defer m.Unlock()
+
+	txEnv := new(txs.Envelope)
+	if err := txEnv.Decode(bs); err != nil {
+		return err
+	}
+	in := txEnv.Tx.Signers()[0]
+	if m.steal > 0 {
+		m.steal--
+		m.sequences[in.Address]++
+	}
+
+	res := abciTypes.ResponseCheckTx{Code: codes.TxExecutionSuccessCode}
+	if err := txEnv.Verify(); err != nil || m.sequences[in.Address]+1 != in.Sequence {
+		res.Code = codes.EncodingErrorCode
+		res.Log = fmt.Sprintf("invalid sequence %d", in.Sequence)
+	} else {
+		m.sequences[in.Address]++
+		res.Data, _ = json.Marshal(txEnv.GenerateReceipt())
+	}
+	cb(abciTypes.ToResponseCheckTx(res))
+	return nil
+}
+
+func TestBroadcastTxAuto(t *testing.T) {
+	signer := crypto.NewAccountSigner(key.GenAccountKey().PrivateKey())
+	receiver := key.GenAccountKey().Address()
+	m := &mempool{sequences: map[crypto.Address]uint64{signer.Address(): 10}}
+	trans := NewTransactor("test-chain", m.checkTx, m, events.NewNopeEventBus())
+	trans.SetSigners([]crypto.Signer{signer})
+
+	newTx := func(from crypto.Address) tx.Tx {
+		sendTx, err := tx.NewSendTx(from, receiver, 0, 100, 1)
+		require.NoError(t, err)
+		return sendTx
+	}
+
+	// Concurrent submissions get consecutive sequences
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := trans.BroadcastTxAuto(newTx(signer.Address()), 0)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, uint64(20), m.sequences[signer.Address()])
+
+	// Re-sequenced after the sequence is taken by another transaction
+	m.steal = 2
+	receipt, err := trans.BroadcastTxAuto(newTx(signer.Address()), 0)
+	require.NoError(t, err)
+	assert.NotEmpty(t, receipt.Hash)
+	assert.Equal(t, uint64(23), m.sequences[signer.Address()])
+
+	// Gives up after too many re-sequences
+	m.steal = maxResequences + 1
+	_, err = trans.BroadcastTxAuto(newTx(signer.Address()), 0)
+	assert.Error(t, err)
+
+	// The node has no key for the signer
+	_, err = trans.BroadcastTxAuto(newTx(receiver), 0)
+	assert.Error(t, err)
+}
+
+func TestBroadcastTxCommit(t *testing.T) {
+	eventBus := events.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	defer eventBus.Stop()
+
+	// commit is how the mempool responds to the transaction, and beforeCheck runs while the mempool is checking it
+	var commit func(txEnv *txs.Envelope, receipt *txs.Receipt)
+	beforeCheck := func() {}
+	checkTx := func(bs tmTypes.Tx, cb func(*abciTypes.Response)) error {
+		beforeCheck()
+		txEnv := new(txs.Envelope)
+		if err := txEnv.Decode(bs); err != nil {
+			return err
+		}
+		if commit == nil {
+			cb(abciTypes.ToResponseCheckTx(abciTypes.ResponseCheckTx{Code: codes.EncodingErrorCode, Log: "rejected"}))
+			return nil
+		}
+		receipt := txEnv.GenerateReceipt()
+		data, _ := json.Marshal(receipt)
+		cb(abciTypes.ToResponseCheckTx(abciTypes.ResponseCheckTx{Code: codes.TxExecutionSuccessCode, Data: data}))
+		go commit(txEnv, receipt)
+		return nil
+	}
+	trans := NewTransactor("test-chain", checkTx, &mempool{}, eventBus)
+	trans.SetCommitTimeout(200 * time.Millisecond)
+
+	signer := crypto.NewAccountSigner(key.GenAccountKey().PrivateKey())
+	sendTx, err := tx.NewSendTx(signer.Address(), key.GenAccountKey().Address(), 1, 100, 1)
+	require.NoError(t, err)
+	txEnv := txs.Enclose("test-chain", sendTx)
+	require.NoError(t, txEnv.Sign(signer))
+
+	// Rejected by the mempool
+	_, err = trans.BroadcastTxCommit(txEnv)
+	assert.Equal(t, e.ErrTxRejected, e.Code(err))
+
+	// Added to the mempool, but not committed
+	commit = func(txEnv *txs.Envelope, receipt *txs.Receipt) {}
+	_, err = trans.BroadcastTxCommit(txEnv)
+	assert.Equal(t, e.ErrTimeOut, e.Code(err))
+
+	// Committed in the block after the receipt
+	commit = func(txEnv *txs.Envelope, receipt *txs.Receipt) {
+		eventBus.Publish(&events.Block{Height: 4, Hash: []byte{4}}, events.TagsForBlock())
+		receipt.Height = 5
+		eventBus.Publish(receipt, events.TagsForTx(receipt.Hash, nil))
+		eventBus.Publish(&events.Block{Height: 5, Hash: []byte{5}}, events.TagsForBlock())
+	}
+	result, err := trans.BroadcastTxCommit(txEnv)
+	require.NoError(t, err)
+	assert.Equal(t, binary.HexBytes(txEnv.Hash()), result.CheckTx.Hash)
+	assert.Equal(t, binary.HexBytes(txEnv.Hash()), result.DeliverTx.Hash)
+	assert.Equal(t, int64(5), result.Height)
+	assert.Equal(t, binary.HexBytes{5}, result.BlockHash)
+
+	// The event bus is not blocked while the mempool is checking the transaction, or after the transactor returns
+	publishBlocks := func(from int64) {
+		for h := from; h < from+5; h++ {
+			eventBus.Publish(&events.Block{Height: h, Hash: []byte{byte(h)}}, events.TagsForBlock())
+		}
+	}
+	beforeCheck = func() { publishBlocks(6) }
+	commit = func(txEnv *txs.Envelope, receipt *txs.Receipt) {
+		receipt.Height = 11
+		eventBus.Publish(receipt, events.TagsForTx(receipt.Hash, nil))
+		publishBlocks(11)
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		result, err = trans.BroadcastTxCommit(txEnv)
+		publishBlocks(16)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the event bus is blocked")
+	}
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), result.Height)
+	assert.Equal(t, binary.HexBytes{11}, result.BlockHash)
+
+	// Expired before it is delivered
+	beforeCheck = func() {}
+	commit = func(txEnv *txs.Envelope, receipt *txs.Receipt) {
+		receipt.Height = 21
+		receipt.Status = txs.Failed
+		eventBus.Publish(receipt, events.TagsForTx(receipt.Hash, nil))
+		eventBus.Publish(&events.Block{Height: 21, Hash: []byte{21}}, events.TagsForBlock())
+	}
+	expiringTx := txs.Enclose("test-chain", sendTx)
+	expiringTx.ValidUntilHeight = 20
+	require.NoError(t, expiringTx.Sign(signer))
+	_, err = trans.BroadcastTxCommit(expiringTx)
+	assert.Equal(t, e.ErrTxExpired, e.Code(err))
+}
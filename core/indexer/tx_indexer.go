@@ -0,0 +1,197 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	bin "github.com/gallactic/gallactic/common/binary"
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/txs"
+	"github.com/gallactic/gallactic/txs/tx"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// Prefix of the keys of the transactions by address
+const accountTxPrefix = "at/"
+
+// AccountTx is a committed transaction which touched an account
+type AccountTx struct {
+	Height uint64       `json:"height"`
+	Index  uint32       `json:"index"`
+	Hash   bin.HexBytes `json:"hash"`
+	Type   tx.Type      `json:"type"`
+	Status int          `json:"status"`
+}
+
+// Cursor is the position of a transaction in the index
+type Cursor struct {
+	Height uint64
+	Index  uint32
+}
+
+// ParseCursor parses the cursor in the form of "height-index"
+func ParseCursor(str string) (*Cursor, error) {
+	parts := strings.Split(str, "-")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid cursor '%s'", str)
+	}
+	height, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid cursor '%s'", str)
+	}
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid cursor '%s'", str)
+	}
+	return &Cursor{Height: height, Index: uint32(index)}, nil
+}
+
+func (c Cursor) String() string {
+	return fmt.Sprintf("%d-%d", c.Height, c.Index)
+}
+
+type pendingTx struct {
+	addrs []crypto.Address
+	tx    AccountTx
+}
+
+// TxIndexer indexes the committed transactions by the addresses they touch.
+// The transactions of a block are added while they are executed and written into the batch of the block at commit.
+type TxIndexer struct {
+	db      dbm.DB
+	lk      sync.Mutex
+	height  uint64
+	index   uint32
+	pending []pendingTx
+}
+
+func NewTxIndexer(db dbm.DB) *TxIndexer {
+	return &TxIndexer{db: db}
+}
+
+func addressPrefixKey(addr crypto.Address) []byte {
+	return append([]byte(accountTxPrefix), addr.RawBytes()...)
+}
+
+func accountTxKey(addr crypto.Address, height uint64, index uint32) []byte {
+	key := addressPrefixKey(addr)
+	bs := make([]byte, 12)
+	binary.BigEndian.PutUint64(bs, height)
+	binary.BigEndian.PutUint32(bs[8:], index)
+	return append(key, bs...)
+}
+
+// Add adds the transaction to the index of each address.
+func (ti *TxIndexer) Add(receipt *txs.Receipt, addrs []crypto.Address) {
+	ti.lk.Lock()
+	defer ti.lk.Unlock()
+
+	height := uint64(receipt.Height)
+	if height != ti.height {
+		ti.height = height
+		ti.index = 0
+	}
+
+	unique := make([]crypto.Address, 0, len(addrs))
+	seen := make(map[crypto.Address]bool)
+	for _, addr := range addrs {
+		if !seen[addr] {
+			seen[addr] = true
+			unique = append(unique, addr)
+		}
+	}
+
+	ti.pending = append(ti.pending, pendingTx{
+		addrs: unique,
+		tx: AccountTx{
+			Height: height,
+			Index:  ti.index,
+			Hash:   receipt.Hash,
+			Type:   receipt.Type,
+			Status: receipt.Status,
+		},
+	})
+	ti.index++
+}
+
+// Index writes the added transactions into the batch
+func (ti *TxIndexer) Index(batch dbm.Batch) error {
+	ti.lk.Lock()
+	defer ti.lk.Unlock()
+
+	for _, p := range ti.pending {
+		bs, err := json.Marshal(&p.tx)
+		if err != nil {
+			return err
+		}
+		for _, addr := range p.addrs {
+			batch.Set(accountTxKey(addr, p.tx.Height, p.tx.Index), bs)
+		}
+	}
+	ti.pending = nil
+	return nil
+}
+
+// Reset drops the transactions which are not committed
+func (ti *TxIndexer) Reset() {
+	ti.lk.Lock()
+	defer ti.lk.Unlock()
+
+	ti.pending = nil
+}
+
+// AccountTxs returns up to limit transactions of the address between fromHeight and toHeight, inclusively.
+// Zero toHeight means no upper bound. The transactions are ordered by height, or in reverse order, and a nil
+// cursor starts from the first one. The cursor of the next transaction is returned if there are more transactions.
+func (ti *TxIndexer) AccountTxs(addr crypto.Address, fromHeight, toHeight uint64,
+	cursor *Cursor, limit int, reverse bool) ([]AccountTx, *Cursor, error) {
+
+	if toHeight == 0 {
+		toHeight = math.MaxUint64
+	}
+	if fromHeight > toHeight {
+		return []AccountTx{}, nil, nil
+	}
+	start := accountTxKey(addr, fromHeight, 0)
+	end := accountTxKey(addr, toHeight, math.MaxUint32)
+	// The end of the range is exclusive
+	end = append(end, 0)
+
+	if cursor != nil {
+		key := accountTxKey(addr, cursor.Height, cursor.Index)
+		if reverse {
+			key = append(key, 0)
+			if string(key) < string(end) {
+				end = key
+			}
+		} else if string(key) > string(start) {
+			start = key
+		}
+	}
+
+	var it dbm.Iterator
+	if reverse {
+		it = ti.db.ReverseIterator(start, end)
+	} else {
+		it = ti.db.Iterator(start, end)
+	}
+	defer it.Close()
+
+	list := make([]AccountTx, 0)
+	for ; it.Valid(); it.Next() {
+		var atx AccountTx
+		if err := json.Unmarshal(it.Value(), &atx); err != nil {
+			return nil, nil, err
+		}
+		if len(list) == limit {
+			return list, &Cursor{Height: atx.Height, Index: atx.Index}, nil
+		}
+		list = append(list, atx)
+	}
+	return list, nil, nil
+}
@@ -0,0 +1,105 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/txs"
+	"github.com/gallactic/gallactic/txs/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func newAddress(secret string) crypto.Address {
+	pb, _ := crypto.GenerateKeyFromSecret(secret)
+	return pb.AccountAddress()
+}
+
+func TestAccountTxs(t *testing.T) {
+	db := dbm.NewMemDB()
+	ti := NewTxIndexer(db)
+	commit := func() {
+		batch := db.NewBatch()
+		require.NoError(t, ti.Index(batch))
+		batch.Write()
+	}
+	addr1 := newAddress("secret1")
+	addr2 := newAddress("secret2")
+	addr3 := newAddress("secret3")
+
+	add := func(height int64, hash byte, addrs ...crypto.Address) {
+		ti.Add(&txs.Receipt{Type: tx.TypeSend, Hash: []byte{hash}, Height: height}, addrs)
+	}
+	// Height 1: addr1 -> addr2, addr1 -> addr1
+	add(1, 1, addr1, addr2)
+	add(1, 2, addr1, addr1)
+	commit()
+	// Height 2: addr2 -> addr3
+	add(2, 3, addr2, addr3)
+	commit()
+	// Not committed
+	add(3, 4, addr1, addr3)
+	ti.Reset()
+	commit()
+
+	hashes := func(list []AccountTx) []byte {
+		var hs []byte
+		for _, atx := range list {
+			hs = append(hs, atx.Hash[0])
+		}
+		return hs
+	}
+
+	list, next, err := ti.AccountTxs(addr1, 0, 0, nil, 10, false)
+	require.NoError(t, err)
+	assert.Nil(t, next)
+	assert.Equal(t, []byte{1, 2}, hashes(list))
+	assert.Equal(t, uint32(1), list[1].Index)
+	assert.Equal(t, tx.TypeSend, list[1].Type)
+
+	list, _, err = ti.AccountTxs(addr2, 0, 0, nil, 10, true)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{3, 1}, hashes(list))
+
+	list, _, err = ti.AccountTxs(addr2, 2, 2, nil, 10, false)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{3}, hashes(list))
+
+	list, _, err = ti.AccountTxs(addr3, 3, 0, nil, 10, false)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	list, _, err = ti.AccountTxs(addr3, 5, 4, nil, 10, false)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	// Pagination
+	list, next, err = ti.AccountTxs(addr1, 0, 0, nil, 1, true)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{2}, hashes(list))
+	require.NotNil(t, next)
+	assert.Equal(t, "1-0", next.String())
+	cursor, err := ParseCursor(next.String())
+	require.NoError(t, err)
+	list, next, err = ti.AccountTxs(addr1, 0, 0, cursor, 1, true)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1}, hashes(list))
+	assert.Nil(t, next)
+
+	list, next, err = ti.AccountTxs(addr2, 0, 0, &Cursor{Height: 2, Index: 0}, 1, false)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{3}, hashes(list))
+	assert.Nil(t, next)
+}
+
+func TestParseCursor(t *testing.T) {
+	c, err := ParseCursor("12-3")
+	require.NoError(t, err)
+	assert.Equal(t, Cursor{Height: 12, Index: 3}, *c)
+
+	for _, str := range []string{"", "12", "12-", "a-1", "1-2-3", "1-99999999999"} {
+		_, err := ParseCursor(str)
+		assert.Error(t, err, str)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/gallactic/gallactic/common"
 	"github.com/gallactic/gallactic/common/process"
 	"github.com/gallactic/gallactic/core/blockchain"
 	"github.com/gallactic/gallactic/core/config"
@@ -18,12 +19,18 @@ import (
 	tmv "github.com/gallactic/gallactic/core/consensus/tendermint/validator" // TODO:::
 	"github.com/gallactic/gallactic/core/events"
 	"github.com/gallactic/gallactic/core/execution"
+	"github.com/gallactic/gallactic/core/indexer"
+	"github.com/gallactic/gallactic/core/logging"
+	"github.com/gallactic/gallactic/core/metrics"
 	"github.com/gallactic/gallactic/core/proposal"
 	"github.com/gallactic/gallactic/core/state"
 	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/keystore"
 	"github.com/gallactic/gallactic/rpc"
+	"github.com/gallactic/gallactic/rpc/auth"
 	"github.com/gallactic/gallactic/rpc/grpc"
 	pb "github.com/gallactic/gallactic/rpc/grpc/proto3"
+	"github.com/gallactic/gallactic/rpc/limit"
 	log "github.com/inconshreveable/log15"
 	dbm "github.com/tendermint/tendermint/libs/db"
 )
@@ -31,6 +38,7 @@ import (
 const (
 	cooldownMilliseconds              = 1000
 	serverShutdownTimeoutMilliseconds = 1000
+	lastSignedInfoFile                = "last_signed_info.json"
 )
 
 // Kernel is the root structure of Gallactic
@@ -44,36 +52,74 @@ type Kernel struct {
 	shutdownOnce   sync.Once
 }
 
-func NewKernel(ctx context.Context, gen *proposal.Genesis, conf *config.Config, myVal crypto.Signer) (*Kernel, error) {
-	handler := log.MultiHandler(
-		log.StreamHandler(os.Stderr, log.TerminalFormat()),
-		log.LvlFilterHandler(
-			log.LvlError,
-			log.Must.FileHandler("errors.log", log.JsonFormat())))
-	log.Root().SetHandler(handler)
+// NewKernel creates a new kernel. Validator's last signed info is loaded from the working directory.
+// If allowMissingSignInfo is set, the node starts even if the last signed info is missing
+// and the chain is beyond genesis. It may cause double signing.
+func NewKernel(ctx context.Context, gen *proposal.Genesis, conf *config.Config, myVal crypto.Signer, allowMissingSignInfo bool) (*Kernel, error) {
+	logConf := conf.Logging
+	if logConf == nil {
+		logConf = config.DefaultLogging()
+	}
+	if err := logging.Setup(logConf); err != nil {
+		return nil, fmt.Errorf("error setting up the logger: %v", err)
+	}
 
 	stateDB := dbm.NewDB("gallactic_state", dbm.GoLevelDBBackend, conf.Tendermint.DBDir())
 	bc, err := blockchain.LoadOrNewBlockchain(stateDB, gen, myVal)
 	if err != nil {
 		return nil, fmt.Errorf("error creating or loading blockchain state: %v", err)
 	}
+	// The transactions are indexed in the state database, so the index is written together with the block
+	txIndexer := indexer.NewTxIndexer(stateDB)
+	bc.SetIndexer(txIndexer)
 	eventBus := events.NewEventBus()
 	if err := eventBus.Start(); err != nil {
 		return nil, err
 	}
 
-	privVal := tmv.NewPrivValidatorMemory(myVal)
+	lastSignedInfo, err := loadLastSignedInfo(bc, allowMissingSignInfo)
+	if err != nil {
+		return nil, err
+	}
+	privVal := tmv.NewPrivValidatorMemory(myVal, lastSignedInfo)
 	checker := execution.NewBatchChecker(bc)
-	committer := execution.NewBatchCommitter(bc, eventBus)
+	committer := execution.NewBatchCommitter(bc, eventBus, txIndexer)
 	tmGenesis := tendermint.DeriveGenesisDoc(gen)
 
-	tmNode, err := tendermint.NewNode(conf.Tendermint, privVal, tmGenesis, bc, checker, committer)
+	var feePolicy execution.FeePolicy
+	if conf.Mempool != nil {
+		feePolicy = execution.FeePolicy{
+			MinFee:        conf.Mempool.MinFee,
+			MinFeePerByte: conf.Mempool.MinFeePerByte,
+			MinFeePerGas:  conf.Mempool.MinFeePerGas,
+		}
+	}
+	tmNode, err := tendermint.NewNode(conf.Tendermint, privVal, tmGenesis, bc, checker, committer, eventBus, feePolicy)
 	if err != nil {
 		return nil, err
 	}
 
-	transactor := execution.NewTransactor(tmNode.MempoolReactor().Mempool.CheckTx, eventBus)
-	service := rpc.NewService(ctx, bc, transactor, query.NewNodeView(tmNode))
+	transactor := execution.NewTransactor(bc.ChainID(), tmNode.MempoolReactor().Mempool.CheckTx, checker.MempoolAccounts(), eventBus)
+	if conf.Transactor != nil && conf.Transactor.CommitTimeout > 0 {
+		transactor.SetCommitTimeout(time.Duration(conf.Transactor.CommitTimeout) * time.Second)
+	}
+	if conf.Keys != nil && conf.Keys.Enabled {
+		// Anyone who can call BroadcastTxAuto can spend from the node-managed accounts
+		if conf.Auth == nil || !conf.Auth.Enabled {
+			return nil, fmt.Errorf("node-managed keys require the authentication of the RPC servers")
+		}
+		signers, err := keystore.LoadSigners(conf.Keys.Dir, conf.Keys.Passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("error loading the node keys: %v", err)
+		}
+		transactor.SetSigners(signers)
+	}
+	service := rpc.NewService(ctx, bc, transactor, checker.MempoolAccounts(), eventBus, txIndexer, query.NewNodeView(tmNode))
+	// The limits are shared by the RPC servers
+	limiter, err := limit.NewLimiter(conf.Limits)
+	if err != nil {
+		return nil, err
+	}
 
 	launchers := []process.Launcher{
 		{
@@ -121,9 +167,16 @@ func NewKernel(ctx context.Context, gen *proposal.Genesis, conf *config.Config,
 			Name:    "RPC",
 			Enabled: conf.RPC.Enabled,
 			Launch: func() (process.Process, error) {
+				authenticator, err := auth.NewAuthenticator(conf.Auth, rpc.MethodLevels)
+				if err != nil {
+					return nil, err
+				}
 				codec := rpc.NewTCodec()
-				jsonServer := rpc.NewJSONServer(rpc.NewJSONService(codec, service))
-				serveProcess, err := rpc.NewServeProcess(conf.RPC.Server, jsonServer)
+				jsonService := rpc.NewJSONService(codec, service, conf.RPC.Admin, authenticator, limiter)
+				jsonServer := rpc.NewJSONServer(jsonService)
+				wsServer := rpc.NewWebSocketServer(jsonService)
+				healthServer := rpc.NewHealthServer(service)
+				serveProcess, err := rpc.NewServeProcess(conf.RPC.Server, authenticator, limiter, jsonServer, wsServer, healthServer)
 				if err != nil {
 					return nil, err
 				}
@@ -138,11 +191,18 @@ func NewKernel(ctx context.Context, gen *proposal.Genesis, conf *config.Config,
 			Name:    "GRPC",
 			Enabled: conf.GRPC.Enabled,
 			Launch: func() (process.Process, error) {
-				grpcServer := grpc.NewGRPCServer()
+				authenticator, err := auth.NewAuthenticator(conf.Auth, grpc.MethodLevels)
+				if err != nil {
+					return nil, err
+				}
+				grpcServer, err := grpc.NewGRPCServer(conf.GRPC.TLS, authenticator, limiter)
+				if err != nil {
+					return nil, err
+				}
 				/// TODO: ‌better design for kernel. They should be encapsulated
-				pb.RegisterBlockChainServer(grpcServer.Server, grpc.NewBlockchainService(bc, query.NewNodeView(tmNode)))
+				pb.RegisterBlockChainServer(grpcServer.Server, grpc.NewBlockchainService(bc, txIndexer, eventBus, checker.MempoolAccounts(), limiter.MaxPageLimit(rpc.MaxPageLimit), query.NewNodeView(tmNode)))
 				pb.RegisterNetworkServer(grpcServer.Server, grpc.NewNetworkService(bc, query.NewNodeView(tmNode)))
-				pb.RegisterTransactionServer(grpcServer.Server, grpc.NewTransactorService(ctx, transactor, query.NewNodeView(tmNode)))
+				pb.RegisterTransactionServer(grpcServer.Server, grpc.NewTransactorService(ctx, transactor, query.NewNodeView(tmNode), conf.RPC.Admin))
 
 				if err := grpcServer.Start(conf.GRPC.ListenAddress); err != nil {
 					return nil, fmt.Errorf("Unable to start grpc server: %v", err)
@@ -150,13 +210,27 @@ func NewKernel(ctx context.Context, gen *proposal.Genesis, conf *config.Config,
 				if err := grpcServer.StartGateway(ctx, conf.GRPC.ListenAddress, conf.GRPC.HTTPAddress); err != nil {
 					return nil, fmt.Errorf("Unable to start grpc-gateway server: %v", err)
 				}
+				healthCtx, stopHealth := context.WithCancel(context.Background())
+				go grpcServer.WatchHealth(healthCtx, query.NewNodeView(tmNode).IsCatchingUp)
 				return process.ShutdownFunc(func(ctx context.Context) error {
+					stopHealth()
 					grpcServer.Stop()
 					// listener is closed for us
 					return nil
 				}), nil
 			},
 		},
+		{
+			Name:    "Metrics",
+			Enabled: conf.Metrics != nil && conf.Metrics.Enabled,
+			Launch: func() (process.Process, error) {
+				srv, err := metrics.StartServer(conf.Metrics.ListenAddress)
+				if err != nil {
+					return nil, fmt.Errorf("Unable to start metrics server: %v", err)
+				}
+				return srv, nil
+			},
+		},
 	}
 
 	return &Kernel{
@@ -167,6 +241,26 @@ func NewKernel(ctx context.Context, gen *proposal.Genesis, conf *config.Config,
 	}, nil
 }
 
+func loadLastSignedInfo(bc *blockchain.Blockchain, allowMissingSignInfo bool) (*tmv.LastSignedInfo, error) {
+	if common.FileExists(lastSignedInfoFile) {
+		lastSignedInfo, err := tmv.LoadLastSignedInfo(lastSignedInfoFile)
+		if err != nil {
+			return nil, fmt.Errorf("error loading last signed info: %v", err)
+		}
+		return lastSignedInfo, nil
+	}
+
+	if bc.LastBlockHeight() > 0 {
+		if !allowMissingSignInfo {
+			return nil, fmt.Errorf("last signed info file (%s) is missing but the chain is at height %d. "+
+				"Restore the file or start the node with --allow-missing-sign-info at your own risk", lastSignedInfoFile, bc.LastBlockHeight())
+		}
+		log.Warn("Last signed info file is missing. Validator may double sign", "file", lastSignedInfoFile, "height", bc.LastBlockHeight())
+	}
+
+	return tmv.NewLastSignedInfo(lastSignedInfoFile), nil
+}
+
 // Boot the kernel starting Tendermint and RPC layers
 func (kern *Kernel) Boot() error {
 	for _, launcher := range kern.launchers {
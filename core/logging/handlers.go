@@ -0,0 +1,130 @@
+package logging
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/inconshreveable/log15"
+)
+
+// moduleFilter drops the records which are below the log level of their module.
+// Records without module are filtered by the default log level.
+type moduleFilter struct {
+	lk      sync.RWMutex
+	defLvl  log.Lvl
+	lvls    map[string]log.Lvl
+	handler log.Handler
+}
+
+func newModuleFilter() *moduleFilter {
+	return &moduleFilter{
+		defLvl:  log.LvlInfo,
+		lvls:    make(map[string]log.Lvl),
+		handler: log.DiscardHandler(),
+	}
+}
+
+func (f *moduleFilter) Log(r *log.Record) error {
+	f.lk.RLock()
+	lvl, ok := f.lvls[recordModule(r)]
+	if !ok {
+		lvl = f.defLvl
+	}
+	handler := f.handler
+	f.lk.RUnlock()
+
+	if r.Lvl > lvl {
+		return nil
+	}
+	return handler.Log(r)
+}
+
+func (f *moduleFilter) set(defLvl log.Lvl, lvls map[string]log.Lvl, handler log.Handler) {
+	f.lk.Lock()
+	defer f.lk.Unlock()
+
+	f.defLvl = defLvl
+	f.lvls = lvls
+	f.handler = handler
+}
+
+func (f *moduleFilter) setDefault(lvl log.Lvl) {
+	f.lk.Lock()
+	defer f.lk.Unlock()
+
+	f.defLvl = lvl
+}
+
+func (f *moduleFilter) setModule(module string, lvl log.Lvl) {
+	f.lk.Lock()
+	defer f.lk.Unlock()
+
+	f.lvls[module] = lvl
+}
+
+func (f *moduleFilter) get() (log.Lvl, map[string]log.Lvl) {
+	f.lk.RLock()
+	defer f.lk.RUnlock()
+
+	lvls := make(map[string]log.Lvl)
+	for module, lvl := range f.lvls {
+		lvls[module] = lvl
+	}
+	return f.defLvl, lvls
+}
+
+// sampler drops the repetitive debug records. For each module and message, the first
+// `initial` records in every tick are passed and then every `thereafter`-th record.
+type sampler struct {
+	lk         sync.Mutex
+	initial    int
+	thereafter int
+	tick       time.Duration
+	resetAt    time.Time
+	counts     map[string]int
+	handler    log.Handler
+}
+
+func newSampler(initial, thereafter int, tick time.Duration, handler log.Handler) *sampler {
+	return &sampler{
+		initial:    initial,
+		thereafter: thereafter,
+		tick:       tick,
+		counts:     make(map[string]int),
+		handler:    handler,
+	}
+}
+
+func (s *sampler) Log(r *log.Record) error {
+	if r.Lvl != log.LvlDebug {
+		return s.handler.Log(r)
+	}
+
+	key := recordModule(r) + "/" + r.Msg
+	s.lk.Lock()
+	if r.Time.After(s.resetAt) {
+		s.counts = make(map[string]int)
+		s.resetAt = r.Time.Add(s.tick)
+	}
+	s.counts[key]++
+	n := s.counts[key]
+	s.lk.Unlock()
+
+	if n <= s.initial {
+		return s.handler.Log(r)
+	}
+	if s.thereafter > 0 && (n-s.initial)%s.thereafter == 0 {
+		return s.handler.Log(r)
+	}
+	return nil
+}
+
+func recordModule(r *log.Record) string {
+	for i := 0; i+1 < len(r.Ctx); i += 2 {
+		if r.Ctx[i] == "module" {
+			module, _ := r.Ctx[i+1].(string)
+			return module
+		}
+	}
+	return ""
+}
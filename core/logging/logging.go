@@ -0,0 +1,163 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/gallactic/gallactic/core/config"
+	log "github.com/inconshreveable/log15"
+)
+
+// Modules of gallactic. Each module has its own logger and log level.
+const (
+	ModuleConsensus = "consensus"
+	ModuleExecution = "execution"
+	ModuleRPC       = "rpc"
+	ModuleEVM       = "evm"
+	ModuleState     = "state"
+)
+
+var modules = []string{ModuleConsensus, ModuleExecution, ModuleRPC, ModuleEVM, ModuleState}
+
+var (
+	lk     sync.Mutex
+	levels = newModuleFilter()
+	closer io.Closer
+)
+
+// New returns a logger for the module
+func New(module string) log.Logger {
+	return log.New("module", module)
+}
+
+// Setup sets the handler of the root logger based on the config.
+// It can be called more than once, the previous log file will be closed.
+func Setup(conf *config.Logging) error {
+	defLvl, err := log.LvlFromString(conf.Level)
+	if err != nil {
+		return err
+	}
+	moduleLvls := make(map[string]log.Lvl)
+	for module, level := range conf.Modules {
+		if !isModule(module) {
+			return fmt.Errorf("Unknown module '%s'. Modules are: %s", module, strings.Join(modules, ", "))
+		}
+		lvl, err := log.LvlFromString(level)
+		if err != nil {
+			return err
+		}
+		moduleLvls[module] = lvl
+	}
+
+	var sinks []log.Handler
+	var file *rotatingFile
+	if conf.Stderr != nil && conf.Stderr.Enabled {
+		format, err := logFormat(conf.Stderr.Format)
+		if err != nil {
+			return err
+		}
+		sinks = append(sinks, log.StreamHandler(os.Stderr, format))
+	}
+	if conf.File != nil && conf.File.Enabled {
+		format, err := logFormat(conf.File.Format)
+		if err != nil {
+			return err
+		}
+		fileLvl, err := log.LvlFromString(conf.File.Level)
+		if err != nil {
+			return err
+		}
+		file, err = newRotatingFile(conf.File.Path, int64(conf.File.MaxSize)*1024*1024, conf.File.MaxBackups)
+		if err != nil {
+			return err
+		}
+		sinks = append(sinks, log.LvlFilterHandler(fileLvl, log.StreamHandler(file, format)))
+	}
+
+	handler := log.MultiHandler(sinks...)
+	if conf.Sampling != nil && conf.Sampling.Enabled {
+		handler = newSampler(conf.Sampling.Initial, conf.Sampling.Thereafter, conf.Sampling.Tick, handler)
+	}
+
+	lk.Lock()
+	defer lk.Unlock()
+
+	levels.set(defLvl, moduleLvls, handler)
+	log.Root().SetHandler(levels)
+
+	if closer != nil {
+		closer.Close()
+		closer = nil
+	}
+	if file != nil {
+		closer = file
+	}
+	return nil
+}
+
+// SetLevel changes the log level of the module at runtime.
+// If module is empty, the default log level is changed.
+func SetLevel(module, level string) error {
+	lvl, err := log.LvlFromString(level)
+	if err != nil {
+		return err
+	}
+	if module == "" {
+		levels.setDefault(lvl)
+		return nil
+	}
+	if !isModule(module) {
+		return fmt.Errorf("Unknown module '%s'. Modules are: %s", module, strings.Join(modules, ", "))
+	}
+	levels.setModule(module, lvl)
+	return nil
+}
+
+// Levels returns the default log level and the log level of all modules
+func Levels() (string, map[string]string) {
+	defLvl, moduleLvls := levels.get()
+	lvls := make(map[string]string)
+	for _, module := range modules {
+		lvl, ok := moduleLvls[module]
+		if !ok {
+			lvl = defLvl
+		}
+		lvls[module] = levelName(lvl)
+	}
+	return levelName(defLvl), lvls
+}
+
+func isModule(module string) bool {
+	for _, m := range modules {
+		if m == module {
+			return true
+		}
+	}
+	return false
+}
+
+// levelName returns the name of the level as it is used in the config
+func levelName(lvl log.Lvl) string {
+	switch lvl {
+	case log.LvlDebug:
+		return "debug"
+	case log.LvlError:
+		return "error"
+	default:
+		return lvl.String()
+	}
+}
+
+func logFormat(format string) (log.Format, error) {
+	switch format {
+	case "terminal", "":
+		return log.TerminalFormat(), nil
+	case "json":
+		return log.JsonFormat(), nil
+	default:
+		return nil, fmt.Errorf("Invalid log format '%s'. It should be 'terminal' or 'json'", format)
+	}
+}
@@ -0,0 +1,142 @@
+package logging
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gallactic/gallactic/core/config"
+	log "github.com/inconshreveable/log15"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recorder struct {
+	records []*log.Record
+}
+
+func (r *recorder) Log(rec *log.Record) error {
+	r.records = append(r.records, rec)
+	return nil
+}
+
+func newRecord(module string, lvl log.Lvl, msg string) *log.Record {
+	return &log.Record{
+		Time: time.Now(),
+		Lvl:  lvl,
+		Msg:  msg,
+		Ctx:  []interface{}{"module", module},
+	}
+}
+
+func TestModuleFilter(t *testing.T) {
+	rec := &recorder{}
+	f := newModuleFilter()
+	f.set(log.LvlInfo, map[string]log.Lvl{ModuleRPC: log.LvlDebug, ModuleEVM: log.LvlError}, rec)
+
+	f.Log(newRecord(ModuleRPC, log.LvlDebug, "rpc"))
+	f.Log(newRecord(ModuleEVM, log.LvlInfo, "evm"))
+	f.Log(newRecord(ModuleState, log.LvlDebug, "state"))
+	f.Log(newRecord(ModuleState, log.LvlInfo, "state"))
+	f.Log(&log.Record{Lvl: log.LvlWarn, Msg: "no module"})
+	require.Equal(t, 3, len(rec.records))
+	assert.Equal(t, "rpc", rec.records[0].Msg)
+	assert.Equal(t, "state", rec.records[1].Msg)
+	assert.Equal(t, "no module", rec.records[2].Msg)
+
+	f.setModule(ModuleEVM, log.LvlDebug)
+	f.Log(newRecord(ModuleEVM, log.LvlDebug, "evm"))
+	assert.Equal(t, 4, len(rec.records))
+}
+
+func TestSetLevel(t *testing.T) {
+	conf := config.DefaultLogging()
+	conf.File.Enabled = false
+	require.NoError(t, Setup(conf))
+
+	require.NoError(t, SetLevel(ModuleRPC, "debug"))
+	require.NoError(t, SetLevel("", "warn"))
+	assert.Error(t, SetLevel("unknown", "debug"))
+	assert.Error(t, SetLevel(ModuleRPC, "verbose"))
+
+	level, modules := Levels()
+	assert.Equal(t, "warn", level)
+	assert.Equal(t, "debug", modules[ModuleRPC])
+	assert.Equal(t, "warn", modules[ModuleEVM])
+	assert.Equal(t, len(modules), 5)
+}
+
+func TestDefaultLevel(t *testing.T) {
+	conf := config.DefaultLogging()
+	conf.File.Enabled = false
+	conf.Level = "debug"
+	require.NoError(t, Setup(conf))
+
+	// The modules follow the default log level unless they are set
+	_, modules := Levels()
+	for module, level := range modules {
+		assert.Equal(t, "debug", level, module)
+	}
+}
+
+func TestInvalidConfig(t *testing.T) {
+	conf := config.DefaultLogging()
+	conf.File.Enabled = false
+
+	conf.Level = "verbose"
+	assert.Error(t, Setup(conf))
+
+	conf = config.DefaultLogging()
+	conf.File.Enabled = false
+	conf.Modules["unknown"] = "info"
+	assert.Error(t, Setup(conf))
+
+	conf = config.DefaultLogging()
+	conf.File.Enabled = false
+	conf.Stderr.Format = "xml"
+	assert.Error(t, Setup(conf))
+}
+
+func TestSampler(t *testing.T) {
+	rec := &recorder{}
+	s := newSampler(2, 3, time.Hour, rec)
+
+	for i := 0; i < 8; i++ {
+		s.Log(newRecord(ModuleState, log.LvlDebug, "repeated"))
+	}
+	// The first 2 records and then every 3rd: 1, 2, 5, 8
+	assert.Equal(t, 4, len(rec.records))
+
+	s.Log(newRecord(ModuleState, log.LvlDebug, "another"))
+	s.Log(newRecord(ModuleState, log.LvlInfo, "repeated"))
+	assert.Equal(t, 6, len(rec.records))
+}
+
+func TestRotatingFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gallactic-logging")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "test.log")
+	rf, err := newRotatingFile(path, 10, 2)
+	require.NoError(t, err)
+	defer rf.Close()
+
+	for _, line := range []string{"aaaaaaaa\n", "bbbbbbbb\n", "cccccccc\n", "dddddddd\n"} {
+		_, err := rf.Write([]byte(line))
+		require.NoError(t, err)
+	}
+
+	read := func(p string) string {
+		data, err := ioutil.ReadFile(p)
+		require.NoError(t, err)
+		return string(data)
+	}
+	assert.Equal(t, "dddddddd\n", read(path))
+	assert.Equal(t, "cccccccc\n", read(path+".1"))
+	assert.Equal(t, "bbbbbbbb\n", read(path+".2"))
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+}
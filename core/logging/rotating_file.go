@@ -0,0 +1,106 @@
+package logging
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// rotatingFile is a writer which rotates the file when it reaches the maximum size.
+// Rotated files are renamed to path.1, path.2, ... and the oldest ones are removed.
+type rotatingFile struct {
+	lk         sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+func newRotatingFile(path string, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	rf := &rotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	if err := rf.open(); err != nil {
+		return nil, err
+	}
+	return rf, nil
+}
+
+func (rf *rotatingFile) Write(p []byte) (int, error) {
+	rf.lk.Lock()
+	defer rf.lk.Unlock()
+
+	if rf.file == nil {
+		return 0, os.ErrClosed
+	}
+	if rf.maxSize > 0 && rf.size > 0 && rf.size+int64(len(p)) > rf.maxSize {
+		if err := rf.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := rf.file.Write(p)
+	rf.size += int64(n)
+	return n, err
+}
+
+func (rf *rotatingFile) Close() error {
+	rf.lk.Lock()
+	defer rf.lk.Unlock()
+
+	if rf.file == nil {
+		return nil
+	}
+	err := rf.file.Close()
+	rf.file = nil
+	return err
+}
+
+func (rf *rotatingFile) open() error {
+	if dir := filepath.Dir(rf.path); dir != "" {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return err
+		}
+	}
+	file, err := os.OpenFile(rf.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	rf.file = file
+	rf.size = info.Size()
+	return nil
+}
+
+func (rf *rotatingFile) rotate() error {
+	if err := rf.file.Close(); err != nil {
+		return err
+	}
+	rf.file = nil
+
+	if rf.maxBackups > 0 {
+		os.Remove(rf.backupPath(rf.maxBackups))
+		for i := rf.maxBackups - 1; i >= 1; i-- {
+			os.Rename(rf.backupPath(i), rf.backupPath(i+1))
+		}
+		if err := os.Rename(rf.path, rf.backupPath(1)); err != nil {
+			return err
+		}
+	} else {
+		if err := os.Remove(rf.path); err != nil {
+			return err
+		}
+	}
+	return rf.open()
+}
+
+func (rf *rotatingFile) backupPath(index int) string {
+	return fmt.Sprintf("%s.%d", rf.path, index)
+}
@@ -0,0 +1,169 @@
+package metrics
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "gallactic"
+
+var (
+	txExecuted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "execution",
+		Name:      "txs_total",
+		Help:      "Number of the committed transactions by type and status.",
+	}, []string{"type", "status"})
+
+	txExecutionSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "execution",
+		Name:      "tx_duration_seconds",
+		Help:      "Execution time of the committed transactions by type.",
+		Buckets:   prometheus.ExponentialBuckets(0.0001, 4, 8),
+	}, []string{"type"})
+
+	blockGasUsed = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "execution",
+		Name:      "block_gas_used",
+		Help:      "Gas used by the transactions of a block.",
+		Buckets:   prometheus.ExponentialBuckets(1000, 4, 10),
+	})
+
+	checkTxRejected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "mempool",
+		Name:      "checktx_rejected_total",
+		Help:      "Number of the transactions rejected by CheckTx by error code.",
+	}, []string{"code"})
+
+	cacheFlushSeconds = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "state",
+		Name:      "cache_flush_duration_seconds",
+		Help:      "Time taken to flush the cache into the state.",
+		Buckets:   prometheus.ExponentialBuckets(0.0001, 4, 8),
+	})
+
+	saveStateSeconds = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "state",
+		Name:      "save_duration_seconds",
+		Help:      "Time taken to save the state into the database.",
+		Buckets:   prometheus.ExponentialBuckets(0.0001, 4, 8),
+	})
+
+	validatorSetSize = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "consensus",
+		Name:      "validator_set_size",
+		Help:      "Number of the validators in the set.",
+	})
+
+	sortitionAttempts = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "consensus",
+		Name:      "sortition_attempts_total",
+		Help:      "Number of the sortitions evaluated by this node.",
+	})
+
+	sortitionWins = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "consensus",
+		Name:      "sortition_wins_total",
+		Help:      "Number of the sortitions won by this node.",
+	})
+
+	rpcRequestSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "request_duration_seconds",
+		Help:      "Time taken to handle the RPC requests by server (jsonrpc or grpc) and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"server", "method"})
+)
+
+var registry = prometheus.NewRegistry()
+
+func init() {
+	registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		txExecuted,
+		txExecutionSeconds,
+		blockGasUsed,
+		checkTxRejected,
+		cacheFlushSeconds,
+		saveStateSeconds,
+		validatorSetSize,
+		sortitionAttempts,
+		sortitionWins,
+		rpcRequestSeconds,
+	)
+}
+
+// TxExecuted records a committed transaction. Status is ok, failed or rejected.
+func TxExecuted(txType, status string, duration time.Duration) {
+	txExecuted.WithLabelValues(txType, status).Inc()
+	txExecutionSeconds.WithLabelValues(txType).Observe(duration.Seconds())
+}
+
+func BlockGasUsed(gas uint64) {
+	blockGasUsed.Observe(float64(gas))
+}
+
+func CheckTxRejected(code string) {
+	checkTxRejected.WithLabelValues(code).Inc()
+}
+
+func CacheFlushed(duration time.Duration) {
+	cacheFlushSeconds.Observe(duration.Seconds())
+}
+
+func StateSaved(duration time.Duration) {
+	saveStateSeconds.Observe(duration.Seconds())
+}
+
+func SetValidatorSetSize(size int) {
+	validatorSetSize.Set(float64(size))
+}
+
+// SortitionEvaluated records a sortition attempt of this node and whether it won.
+func SortitionEvaluated(won bool) {
+	sortitionAttempts.Inc()
+	if won {
+		sortitionWins.Inc()
+	}
+}
+
+// RPCRequest records the handling time of a request. Server is jsonrpc or grpc.
+func RPCRequest(server, method string, duration time.Duration) {
+	rpcRequestSeconds.WithLabelValues(server, method).Observe(duration.Seconds())
+}
+
+// Handler returns the http handler which serves the metrics in the Prometheus format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// StartServer serves the metrics on the `/metrics` path of the listen address.
+// The returned server should be shut down by the caller.
+func StartServer(listenAddress string) (*http.Server, error) {
+	lis, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+	srv := &http.Server{Handler: mux}
+
+	go srv.Serve(lis)
+
+	return srv, nil
+}
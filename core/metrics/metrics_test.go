@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readCounter(t *testing.T, c prometheus.Counter) float64 {
+	m := &dto.Metric{}
+	require.NoError(t, c.Write(m))
+	return m.GetCounter().GetValue()
+}
+
+func TestHandler(t *testing.T) {
+	txExecuted.Reset()
+	checkTxRejected.Reset()
+	rpcRequestSeconds.Reset()
+	attempts := readCounter(t, sortitionAttempts)
+	wins := readCounter(t, sortitionWins)
+
+	TxExecuted("Send", "ok", time.Millisecond)
+	CheckTxRejected("8")
+	SetValidatorSetSize(4)
+	SortitionEvaluated(true)
+	SortitionEvaluated(false)
+	RPCRequest("jsonrpc", "gallactic.getStatus", time.Millisecond)
+
+	w := httptest.NewRecorder()
+	Handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	body, err := ioutil.ReadAll(w.Body)
+	require.NoError(t, err)
+
+	out := string(body)
+	assert.Contains(t, out, `gallactic_execution_txs_total{status="ok",type="Send"} 1`)
+	assert.Contains(t, out, `gallactic_mempool_checktx_rejected_total{code="8"} 1`)
+	assert.Contains(t, out, `gallactic_consensus_validator_set_size 4`)
+	assert.Contains(t, out, `gallactic_consensus_sortition_attempts_total`)
+	assert.Equal(t, attempts+2, readCounter(t, sortitionAttempts))
+	assert.Equal(t, wins+1, readCounter(t, sortitionWins))
+	assert.Contains(t, out, `gallactic_rpc_request_duration_seconds_count{method="gallactic.getStatus",server="jsonrpc"} 1`)
+}
@@ -11,8 +11,10 @@ import (
 
 	"github.com/gallactic/gallactic/common"
 	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/account/permission"
 	"github.com/gallactic/gallactic/core/validator"
 	"github.com/gallactic/gallactic/crypto"
+	e "github.com/gallactic/gallactic/errors"
 	amino "github.com/tendermint/go-amino"
 )
 
@@ -288,6 +290,192 @@ func MakeGenesis(chainName string, genesisTime time.Time,
 	}
 }
 
+// AddAccount adds a new account to the genesis
+func (gen *Genesis) AddAccount(addr crypto.Address, balance uint64, perm account.Permissions) error {
+	genAcc := genAccount{Address: addr, Balance: balance, Permissions: perm}
+	if err := genAcc.ensureValid(); err != nil {
+		return err
+	}
+	if gen.hasAddress(addr) {
+		return e.Errorf(e.ErrDuplicateAddress, "Address %s already exists in the genesis", addr)
+	}
+	gen.data.Accounts = append(gen.data.Accounts, genAcc)
+	sort.SliceStable(gen.data.Accounts, func(i, j int) bool {
+		return bytes.Compare(gen.data.Accounts[i].Address.RawBytes(), gen.data.Accounts[j].Address.RawBytes()) < 0
+	})
+	return nil
+}
+
+// AddContract adds a new contract to the genesis
+func (gen *Genesis) AddContract(addr crypto.Address, code []byte, perm account.Permissions) error {
+	genCt := genContract{Address: addr, Code: code, Permissions: perm}
+	if err := genCt.ensureValid(); err != nil {
+		return err
+	}
+	if gen.hasAddress(addr) {
+		return e.Errorf(e.ErrDuplicateAddress, "Address %s already exists in the genesis", addr)
+	}
+	gen.data.Contracts = append(gen.data.Contracts, genCt)
+	sort.SliceStable(gen.data.Contracts, func(i, j int) bool {
+		return bytes.Compare(gen.data.Contracts[i].Address.RawBytes(), gen.data.Contracts[j].Address.RawBytes()) < 0
+	})
+	return nil
+}
+
+// AddValidator adds a new validator to the genesis
+func (gen *Genesis) AddValidator(pubKey crypto.PublicKey, stake uint64) error {
+	genVal := genValidator{Address: pubKey.ValidatorAddress(), Stake: stake, PublicKey: pubKey}
+	if err := genVal.ensureValid(); err != nil {
+		return err
+	}
+	if gen.hasAddress(genVal.Address) {
+		return e.Errorf(e.ErrDuplicateAddress, "Address %s already exists in the genesis", genVal.Address)
+	}
+	gen.data.Validators = append(gen.data.Validators, genVal)
+	sort.SliceStable(gen.data.Validators, func(i, j int) bool {
+		return bytes.Compare(gen.data.Validators[i].Address.RawBytes(), gen.data.Validators[j].Address.RawBytes()) < 0
+	})
+	return nil
+}
+
+// SetGlobalAccount sets the balance, permissions and code of the global account
+func (gen *Genesis) SetGlobalAccount(balance uint64, perm account.Permissions, code []byte) error {
+	if !permission.EnsureValid(perm) {
+		return e.Errorf(e.ErrInvalidPermission, "Invalid permissions for the global account: %v", perm)
+	}
+	gen.data.GlobalAccount = globalAccount{Balance: balance, Permissions: perm, Code: code}
+	return nil
+}
+
+// Validate checks the genesis for the errors which make the blockchain unable to start
+// or silently drop some of the accounts or validators.
+func (gen *Genesis) Validate() error {
+	if err := gen.ValidateForStartup(); err != nil {
+		return err
+	}
+	for _, genAcc := range gen.data.Accounts {
+		if err := genAcc.ensureValid(); err != nil {
+			return err
+		}
+	}
+	for _, genCt := range gen.data.Contracts {
+		if err := genCt.ensureValid(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateForStartup checks the genesis for the errors which the blockchain can't start with. The accounts
+// with invalid addresses are dropped, as the other nodes of the chain did when they started from the same genesis.
+func (gen *Genesis) ValidateForStartup() error {
+	if gen.data.GenesisTime.IsZero() {
+		return fmt.Errorf("Genesis time didn't set inside genesis doc")
+	}
+	if len(gen.data.Validators) == 0 {
+		return fmt.Errorf("The genesis file has no validators")
+	}
+	if !permission.EnsureValid(gen.data.GlobalAccount.Permissions) {
+		return e.Errorf(e.ErrInvalidPermission, "Invalid permissions for the global account: %v",
+			gen.data.GlobalAccount.Permissions)
+	}
+
+	addrs := make(map[crypto.Address]bool)
+	checkDuplicate := func(addr crypto.Address) error {
+		if addrs[addr] {
+			return e.Errorf(e.ErrDuplicateAddress, "Duplicate address in the genesis: %s", addr)
+		}
+		addrs[addr] = true
+		return nil
+	}
+	addrs[crypto.GlobalAddress] = true
+
+	for _, genAcc := range gen.data.Accounts {
+		if !permission.EnsureValid(genAcc.Permissions) {
+			return e.Errorf(e.ErrInvalidPermission, "Invalid permissions for account %s: %v", genAcc.Address, genAcc.Permissions)
+		}
+		if err := checkDuplicate(genAcc.Address); err != nil {
+			return err
+		}
+	}
+	for _, genCt := range gen.data.Contracts {
+		if !permission.EnsureValid(genCt.Permissions) {
+			return e.Errorf(e.ErrInvalidPermission, "Invalid permissions for contract %s: %v", genCt.Address, genCt.Permissions)
+		}
+		if err := checkDuplicate(genCt.Address); err != nil {
+			return err
+		}
+	}
+	for _, genVal := range gen.data.Validators {
+		if err := genVal.ensureValid(); err != nil {
+			return err
+		}
+		if err := checkDuplicate(genVal.Address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (gen *Genesis) hasAddress(addr crypto.Address) bool {
+	if addr.EqualsTo(crypto.GlobalAddress) {
+		return true
+	}
+	for _, genAcc := range gen.data.Accounts {
+		if genAcc.Address.EqualsTo(addr) {
+			return true
+		}
+	}
+	for _, genCt := range gen.data.Contracts {
+		if genCt.Address.EqualsTo(addr) {
+			return true
+		}
+	}
+	for _, genVal := range gen.data.Validators {
+		if genVal.Address.EqualsTo(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (genAcc genAccount) ensureValid() error {
+	if err := genAcc.Address.EnsureValid(); err != nil {
+		return err
+	}
+	if !genAcc.Address.IsAccountAddress() {
+		return e.Errorf(e.ErrInvalidAddress, "This is not a valid account address: %s", genAcc.Address)
+	}
+	if !permission.EnsureValid(genAcc.Permissions) {
+		return e.Errorf(e.ErrInvalidPermission, "Invalid permissions for account %s: %v", genAcc.Address, genAcc.Permissions)
+	}
+	return nil
+}
+
+func (genCt genContract) ensureValid() error {
+	if err := genCt.Address.EnsureValid(); err != nil {
+		return err
+	}
+	if !genCt.Address.IsContractAddress() {
+		return e.Errorf(e.ErrInvalidAddress, "This is not a valid contract address: %s", genCt.Address)
+	}
+	if !permission.EnsureValid(genCt.Permissions) {
+		return e.Errorf(e.ErrInvalidPermission, "Invalid permissions for contract %s: %v", genCt.Address, genCt.Permissions)
+	}
+	return nil
+}
+
+func (genVal genValidator) ensureValid() error {
+	if err := genVal.PublicKey.EnsureValid(); err != nil {
+		return err
+	}
+	if !genVal.Address.EqualsTo(genVal.PublicKey.ValidatorAddress()) {
+		return e.Errorf(e.ErrInvalidAddress, "Validator address %s doesn't match the public key %s",
+			genVal.Address, genVal.PublicKey)
+	}
+	return nil
+}
+
 // LoadFromFile loads genesis object from a JSON file
 func LoadFromFile(file string) (*Genesis, error) {
 	dat, err := ioutil.ReadFile(file)
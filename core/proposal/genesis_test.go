@@ -0,0 +1,117 @@
+package proposal
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/account/permission"
+	"github.com/gallactic/gallactic/core/validator"
+	"github.com/gallactic/gallactic/crypto"
+	e "github.com/gallactic/gallactic/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeTestGenesis(t *testing.T) *Genesis {
+	pubKey, _ := crypto.GenerateKey(nil)
+	val, err := validator.NewValidator(pubKey, 0)
+	require.NoError(t, err)
+	gAcc, _ := account.NewAccount(crypto.GlobalAddress)
+	return MakeGenesis("test-chain", time.Now().Truncate(0), gAcc, nil, nil, []*validator.Validator{val})
+}
+
+func TestEditGenesis(t *testing.T) {
+	gen := makeTestGenesis(t)
+	require.NoError(t, gen.Validate())
+
+	pubKey1, _ := crypto.GenerateKey(nil)
+	pubKey2, _ := crypto.GenerateKey(nil)
+	pubKey3, _ := crypto.GenerateKey(nil)
+	ctAddr := crypto.DeriveContractAddress(pubKey1.AccountAddress(), 1)
+
+	require.NoError(t, gen.AddAccount(pubKey1.AccountAddress(), 1000, permission.Send))
+	require.NoError(t, gen.AddContract(ctAddr, []byte{0x60, 0x60}, permission.Call))
+	require.NoError(t, gen.AddValidator(pubKey2, 10))
+	require.NoError(t, gen.SetGlobalAccount(500, permission.AllPermissions, nil))
+	require.NoError(t, gen.Validate())
+
+	// duplicates
+	assert.Equal(t, e.ErrDuplicateAddress, e.Code(gen.AddAccount(pubKey1.AccountAddress(), 1, 0)))
+	assert.Equal(t, e.ErrDuplicateAddress, e.Code(gen.AddContract(ctAddr, []byte{0x60}, 0)))
+	assert.Equal(t, e.ErrDuplicateAddress, e.Code(gen.AddValidator(pubKey2, 1)))
+	assert.Equal(t, e.ErrDuplicateAddress, e.Code(gen.AddAccount(crypto.GlobalAddress, 1, 0)))
+
+	// invalid address types and permissions
+	assert.Equal(t, e.ErrInvalidAddress, e.Code(gen.AddAccount(ctAddr, 1, 0)))
+	assert.Equal(t, e.ErrInvalidAddress, e.Code(gen.AddContract(pubKey3.AccountAddress(), []byte{0x60}, 0)))
+	assert.Equal(t, e.ErrInvalidPermission, e.Code(gen.AddAccount(pubKey3.AccountAddress(), 1, permission.Reserved)))
+	assert.Equal(t, e.ErrInvalidPermission, e.Code(gen.SetGlobalAccount(1, permission.Reserved, nil)))
+
+	// Accounts and validators are loaded
+	assert.Equal(t, 2, len(gen.Accounts())) // global and account
+	assert.Equal(t, 2, len(gen.Validators()))
+	assert.Equal(t, uint64(500), gen.GlobalAccount().Balance())
+
+	// Saving and loading keeps the genesis valid
+	bs, err := gen.MarshalJSON()
+	require.NoError(t, err)
+	gen2 := new(Genesis)
+	require.NoError(t, gen2.UnmarshalJSON(bs))
+	assert.NoError(t, gen2.Validate())
+	assert.Equal(t, gen.Hash(), gen2.Hash())
+}
+
+func TestValidateGenesis(t *testing.T) {
+	pubKey1, _ := crypto.GenerateKey(nil)
+	pubKey2, _ := crypto.GenerateKey(nil)
+
+	// modify decodes the genesis into a map, modifies it and encodes it back
+	modify := func(f func(m map[string]interface{})) *Genesis {
+		bs, err := makeTestGenesis(t).MarshalJSON()
+		require.NoError(t, err)
+		m := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal(bs, &m))
+		f(m)
+		bs, err = json.Marshal(m)
+		require.NoError(t, err)
+		gen := new(Genesis)
+		require.NoError(t, gen.UnmarshalJSON(bs))
+		return gen
+	}
+
+	gen := modify(func(m map[string]interface{}) { delete(m, "genesisTime") })
+	assert.Error(t, gen.Validate())
+
+	gen = modify(func(m map[string]interface{}) { m["validators"] = nil })
+	assert.Error(t, gen.Validate())
+
+	gen = modify(func(m map[string]interface{}) {
+		vals := m["validators"].([]interface{})
+		vals[0].(map[string]interface{})["address"] = pubKey1.ValidatorAddress().String()
+	})
+	assert.Equal(t, e.ErrInvalidAddress, e.Code(gen.Validate()))
+
+	gen = modify(func(m map[string]interface{}) {
+		acc := map[string]interface{}{"address": pubKey2.AccountAddress().String(), "balance": 1}
+		m["accounts"] = []interface{}{acc, acc}
+	})
+	assert.Equal(t, e.ErrDuplicateAddress, e.Code(gen.Validate()))
+
+	gen = modify(func(m map[string]interface{}) {
+		acc := map[string]interface{}{"address": pubKey2.AccountAddress().String(), "permissions": "0xffff"}
+		m["accounts"] = []interface{}{acc}
+	})
+	assert.Equal(t, e.ErrInvalidPermission, e.Code(gen.Validate()))
+	assert.Equal(t, e.ErrInvalidPermission, e.Code(gen.ValidateForStartup()))
+
+	// The genesis files which started the existing chains are not rejected at startup
+	gen = modify(func(m map[string]interface{}) {
+		ctAddr := crypto.DeriveContractAddress(pubKey1.AccountAddress(), 1)
+		m["accounts"] = []interface{}{map[string]interface{}{"address": ctAddr.String(), "balance": 1}}
+		m["chainName"] = ""
+	})
+	assert.Equal(t, e.ErrInvalidAddress, e.Code(gen.Validate()))
+	assert.NoError(t, gen.ValidateForStartup())
+}
@@ -1,6 +1,8 @@
 package sortition
 
 import (
+	"github.com/gallactic/gallactic/core/logging"
+	"github.com/gallactic/gallactic/core/metrics"
 	"github.com/gallactic/gallactic/core/state"
 	"github.com/gallactic/gallactic/core/validator"
 	"github.com/gallactic/gallactic/crypto"
@@ -26,6 +28,7 @@ func NewSortition(state *state.State, signer crypto.Signer, chainID string) *Sor
 		state:   state,
 		chainID: chainID,
 		vrf:     NewVRF(signer),
+		logger:  logging.New(logging.ModuleConsensus),
 	}
 }
 
@@ -36,8 +39,9 @@ func (s *Sortition) Evaluate(blockHeight uint64, blockHash []byte) {
 	s.vrf.SetMax(totalStake)
 	index, proof := s.vrf.Evaluate(blockHash)
 
+	metrics.SortitionEvaluated(index < valStake)
 	if index < valStake {
-		log.Info("This validator is chosen to be in set", "height", blockHeight, "address", addr, "stake", valStake)
+		s.logger.Info("This validator is chosen to be in set", "height", blockHeight, "address", addr, "stake", valStake)
 
 		/// TODO: better way????
 		val, err := s.state.GetValidator(s.Address())
@@ -87,7 +91,7 @@ func (s *Sortition) Verify(blockHash []byte, pb crypto.PublicKey, index uint64,
 
 	index2, result := s.vrf.Verify(blockHash, pb, proof)
 	if !result {
-		log.Warn("Unable to verify a sortition tx", "blockhash", blockHash, "Address", addr)
+		s.logger.Warn("Unable to verify a sortition tx", "blockhash", blockHash, "Address", addr)
 		return false
 	}
 
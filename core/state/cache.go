@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/gallactic/gallactic/common/orderedmap"
 
 	"github.com/gallactic/gallactic/common/binary"
 	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/metrics"
 	"github.com/gallactic/gallactic/core/validator"
 	"github.com/gallactic/gallactic/crypto"
 )
@@ -74,6 +76,10 @@ func (c *Cache) Flush(set *validator.ValidatorSet) error {
 	c.Lock()
 	defer c.Unlock()
 
+	defer func(start time.Time) {
+		metrics.CacheFlushed(time.Since(start))
+	}(time.Now())
+
 	c.accChanges.Iter(func(key, value interface{}) (more bool) {
 		addr := key.(crypto.Address)
 		i := value.(*accountInfo)
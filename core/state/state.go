@@ -3,10 +3,12 @@ package state
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/gallactic/gallactic/common/binary"
 	"github.com/gallactic/gallactic/core/account"
 	"github.com/gallactic/gallactic/core/account/permission"
+	"github.com/gallactic/gallactic/core/metrics"
 	"github.com/gallactic/gallactic/core/proposal"
 	"github.com/gallactic/gallactic/core/validator"
 	"github.com/gallactic/gallactic/crypto"
@@ -29,7 +31,6 @@ const (
 var (
 	accountsStart, accountsEnd   []byte = prefixKeyRange(accountPrefix)
 	validatorStart, validatorEnd []byte = prefixKeyRange(validatorPrefix)
-	storageStart, storageEnd     []byte = prefixKeyRange(storagePrefix)
 )
 
 func prefixedKey(prefix string, suffixes ...[]byte) []byte {
@@ -56,6 +57,11 @@ func prefixKeyRange(prefix string) (start, end []byte) {
 	return
 }
 
+// storageKeyRange returns the range of storage keys for the given account
+func storageKeyRange(addr crypto.Address) (start, end []byte) {
+	return prefixKeyRange(string(prefixedKey(storagePrefix, addr.RawBytes())))
+}
+
 func accountKey(addr crypto.Address) []byte {
 	return prefixedKey(accountPrefix, addr.RawBytes())
 }
@@ -64,6 +70,21 @@ func validatorKey(addr crypto.Address) []byte {
 	return prefixedKey(validatorPrefix, addr.RawBytes())
 }
 
+// keyRangeFrom narrows the range to begin from the given key. In reverse order
+// the range ends at the given key, inclusively.
+func keyRangeFrom(start, end, from []byte, reverse bool) ([]byte, []byte) {
+	if from == nil {
+		return start, end
+	}
+	if reverse {
+		// The end of the range is exclusive
+		end = make([]byte, len(from)+1)
+		copy(end, from)
+		return start, end
+	}
+	return from, end
+}
+
 type State struct {
 	sync.Mutex
 	db   dbm.DB
@@ -127,6 +148,10 @@ func (st *State) SaveState() ([]byte, error) {
 	st.Lock()
 	defer st.Unlock()
 
+	defer func(start time.Time) {
+		metrics.StateSaved(time.Since(start))
+	}(time.Now())
+
 	hash, version, err := st.tree.SaveVersion()
 	if err != nil {
 		return nil, err
@@ -195,7 +220,18 @@ func (st *State) AccountCount() int {
 }
 
 func (st *State) IterateAccounts(consumer func(*account.Account) (stop bool)) (stopped bool, err error) {
-	stopped = st.tree.IterateRange(accountsStart, accountsEnd, true, func(key, bs []byte) bool {
+	return st.IterateAccountsFrom(nil, false, consumer)
+}
+
+// IterateAccountsFrom iterates the accounts ordered by address, starting from the given address.
+// If from is nil, it starts from the first account, or from the last one in reverse order.
+func (st *State) IterateAccountsFrom(from *crypto.Address, reverse bool, consumer func(*account.Account) (stop bool)) (stopped bool, err error) {
+	var fromKey []byte
+	if from != nil {
+		fromKey = accountKey(*from)
+	}
+	start, end := keyRangeFrom(accountsStart, accountsEnd, fromKey, reverse)
+	stopped = st.tree.IterateRange(start, end, !reverse, func(key, bs []byte) bool {
 		acc, err := account.AccountFromBytes(bs)
 		if err != nil {
 			return true
@@ -280,7 +316,18 @@ func (st *State) ValidatorCount() int {
 }
 
 func (st *State) IterateValidators(consumer func(*validator.Validator) (stop bool)) (stopped bool, err error) {
-	return st.tree.IterateRange(validatorStart, validatorEnd, true, func(key []byte, bs []byte) (stop bool) {
+	return st.IterateValidatorsFrom(nil, false, consumer)
+}
+
+// IterateValidatorsFrom iterates the validators ordered by address, starting from the given address.
+// If from is nil, it starts from the first validator, or from the last one in reverse order.
+func (st *State) IterateValidatorsFrom(from *crypto.Address, reverse bool, consumer func(*validator.Validator) (stop bool)) (stopped bool, err error) {
+	var fromKey []byte
+	if from != nil {
+		fromKey = validatorKey(*from)
+	}
+	start, end := keyRangeFrom(validatorStart, validatorEnd, fromKey, reverse)
+	return st.tree.IterateRange(start, end, !reverse, func(key []byte, bs []byte) (stop bool) {
 		validator, err := validator.ValidatorFromBytes(bs)
 		if err != nil {
 			return true
@@ -299,7 +346,10 @@ func (st *State) GetStorage(addr crypto.Address, key binary.Word256) (binary.Wor
 
 func (st *State) IterateStorage(addr crypto.Address,
 	consumer func(key, value binary.Word256) (stop bool)) (stopped bool, err error) {
-	stopped = st.tree.IterateRange(storageStart, storageEnd, true, func(key []byte, value []byte) (stop bool) {
+	start, end := storageKeyRange(addr)
+	stopped = st.tree.IterateRange(start, end, true, func(key []byte, value []byte) (stop bool) {
+		// Strip the prefix and the address from the key
+		key = key[len(start):]
 		// Note: no left padding should occur unless there is a bug and non-words have been writte to this storage tree
 		if len(key) != binary.Word256Length {
 			err = fmt.Errorf("key '%X' stored for account %s is not a %v-byte word",
@@ -308,7 +358,7 @@ func (st *State) IterateStorage(addr crypto.Address,
 		}
 		if len(value) != binary.Word256Length {
 			err = fmt.Errorf("value '%X' stored for account %s is not a %v-byte word",
-				key, addr, binary.Word256Length)
+				value, addr, binary.Word256Length)
 			return true
 		}
 		return consumer(binary.LeftPadWord256(key), binary.LeftPadWord256(value))
@@ -349,7 +399,18 @@ func (st *State) removeAccount(addr crypto.Address) error {
 	defer st.Unlock()
 
 	st.tree.Remove(accountKey(addr))
-	/// TODO: Remove all storages assigned to this account
+
+	// Remove all storages assigned to this account.
+	// Keys are collected first, the tree shouldn't be modified while iterating.
+	var keys [][]byte
+	start, end := storageKeyRange(addr)
+	st.tree.IterateRange(start, end, true, func(key []byte, value []byte) (stop bool) {
+		keys = append(keys, key)
+		return false
+	})
+	for _, key := range keys {
+		st.tree.Remove(key)
+	}
 	return nil
 }
 
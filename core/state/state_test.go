@@ -1,11 +1,16 @@
 package state
 
 import (
+	"bytes"
+	"fmt"
+	"sort"
 	"testing"
 
+	"github.com/gallactic/gallactic/common/binary"
 	"github.com/gallactic/gallactic/core/account"
 	"github.com/gallactic/gallactic/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tendermint/libs/db"
 )
 
@@ -31,3 +36,100 @@ func TestStateChanges(t *testing.T) {
 	assert.Equal(t, acc1, acc2)
 	assert.Equal(t, st.AccountCount(), 1)
 }
+
+func dumpStorage(t *testing.T, st *State, addr crypto.Address) map[binary.Word256]binary.Word256 {
+	storage := make(map[binary.Word256]binary.Word256)
+	_, err := st.IterateStorage(addr, func(key, value binary.Word256) (stop bool) {
+		storage[key] = value
+		return false
+	})
+	require.NoError(t, err)
+	return storage
+}
+
+func TestIterateStorage(t *testing.T) {
+	st := newState()
+	pb, _ := crypto.GenerateKeyFromSecret("secret1")
+	addr1 := crypto.DeriveContractAddress(pb.AccountAddress(), 1)
+	addr2 := crypto.DeriveContractAddress(pb.AccountAddress(), 2)
+
+	assert.Empty(t, dumpStorage(t, st, addr1))
+
+	st.setStorage(addr1, binary.Uint64ToWord256(1), binary.Uint64ToWord256(10))
+	st.setStorage(addr1, binary.Uint64ToWord256(2), binary.Uint64ToWord256(20))
+	st.setStorage(addr2, binary.Uint64ToWord256(1), binary.Uint64ToWord256(100))
+
+	storage1 := dumpStorage(t, st, addr1)
+	assert.Equal(t, 2, len(storage1))
+	assert.Equal(t, binary.Uint64ToWord256(10), storage1[binary.Uint64ToWord256(1)])
+	assert.Equal(t, binary.Uint64ToWord256(20), storage1[binary.Uint64ToWord256(2)])
+
+	storage2 := dumpStorage(t, st, addr2)
+	assert.Equal(t, 1, len(storage2))
+	assert.Equal(t, binary.Uint64ToWord256(100), storage2[binary.Uint64ToWord256(1)])
+
+	// Stop iterating
+	count := 0
+	stopped, err := st.IterateStorage(addr1, func(key, value binary.Word256) (stop bool) {
+		count++
+		return true
+	})
+	assert.NoError(t, err)
+	assert.True(t, stopped)
+	assert.Equal(t, 1, count)
+}
+
+func TestRemoveAccountStorage(t *testing.T) {
+	st := newState()
+	pb, _ := crypto.GenerateKeyFromSecret("secret1")
+	addr1 := crypto.DeriveContractAddress(pb.AccountAddress(), 1)
+	addr2 := crypto.DeriveContractAddress(pb.AccountAddress(), 2)
+	acc1, _ := account.NewContractAccount(addr1)
+	acc2, _ := account.NewContractAccount(addr2)
+	st.updateAccount(acc1)
+	st.updateAccount(acc2)
+
+	st.setStorage(addr1, binary.Uint64ToWord256(1), binary.Uint64ToWord256(10))
+	st.setStorage(addr1, binary.Uint64ToWord256(2), binary.Uint64ToWord256(20))
+	st.setStorage(addr2, binary.Uint64ToWord256(1), binary.Uint64ToWord256(100))
+
+	require.NoError(t, st.removeAccount(addr1))
+	assert.False(t, st.HasAccount(addr1))
+	assert.Empty(t, dumpStorage(t, st, addr1))
+	val, err := st.GetStorage(addr1, binary.Uint64ToWord256(1))
+	assert.NoError(t, err)
+	assert.Equal(t, binary.Zero256, val)
+
+	// Storage of other accounts is untouched
+	assert.True(t, st.HasAccount(addr2))
+	assert.Equal(t, 1, len(dumpStorage(t, st, addr2)))
+}
+
+func TestIterateAccountsFrom(t *testing.T) {
+	st := newState()
+	addrs := make([]crypto.Address, 5)
+	for i := range addrs {
+		acc := account.NewAccountFromSecret(fmt.Sprintf("secret%d", i))
+		st.updateAccount(acc)
+		addrs[i] = acc.Address()
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].RawBytes(), addrs[j].RawBytes()) < 0
+	})
+
+	collect := func(from *crypto.Address, reverse bool) []crypto.Address {
+		var list []crypto.Address
+		_, err := st.IterateAccountsFrom(from, reverse, func(acc *account.Account) (stop bool) {
+			list = append(list, acc.Address())
+			return false
+		})
+		require.NoError(t, err)
+		return list
+	}
+
+	assert.Equal(t, addrs, collect(nil, false))
+	assert.Equal(t, addrs[2:], collect(&addrs[2], false))
+	assert.Equal(t, []crypto.Address{addrs[2], addrs[1], addrs[0]}, collect(&addrs[2], true))
+	assert.Equal(t, 5, len(collect(nil, true)))
+	assert.Equal(t, addrs[4], collect(nil, true)[0])
+}
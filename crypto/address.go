@@ -149,6 +149,9 @@ func (addr *Address) Size() int {
 
 func (addr *Address) EnsureValid() error {
 	bs := addr.RawBytes()
+	if bs == nil {
+		return e.Errorf(e.ErrInvalidAddress, "Address is empty")
+	}
 	err := validateChecksum(bs)
 	if err != nil {
 		return e.Errorf(e.ErrInvalidAddress, err.Error())
@@ -20,6 +20,9 @@ const (
 	ErrInsufficientFunds
 	ErrInsufficientGas
 	ErrPermissionDenied
+	ErrTxRejected
+	ErrTxExpired
+	ErrInsufficientFee
 
 	ErrCount
 )
@@ -40,6 +43,9 @@ var messages = map[int]string{
 	ErrInsufficientFunds: "error insufficient funds",
 	ErrInsufficientGas:   "Insufficient Gas",
 	ErrPermissionDenied:  "Permission denied",
+	ErrTxRejected:        "Transaction is rejected by the mempool",
+	ErrTxExpired:         "Transaction is expired",
+	ErrInsufficientFee:   "Insufficient fee",
 }
 
 type withCode struct {
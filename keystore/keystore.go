@@ -1 +1,38 @@
 package keystore
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/keystore/key"
+)
+
+// LoadSigners decrypts the key files of the directory. Validator keys make validator signers
+// and the other keys make account signers.
+func LoadSigners(dir, passphrase string) ([]crypto.Signer, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var signers []crypto.Signer
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".json") {
+			continue
+		}
+		k, err := key.DecryptKeyFile(filepath.Join(dir, f.Name()), passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to decrypt key file %s: %v", f.Name(), err)
+		}
+		addr := k.Address()
+		if addr.IsValidatorAddress() {
+			signers = append(signers, crypto.NewValidatorSigner(k.PrivateKey()))
+		} else {
+			signers = append(signers, crypto.NewAccountSigner(k.PrivateKey()))
+		}
+	}
+	return signers, nil
+}
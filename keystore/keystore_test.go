@@ -0,0 +1,36 @@
+package keystore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gallactic/gallactic/keystore/key"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSigners(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	accKey := key.GenAccountKey()
+	valKey := key.GenValidatorKey()
+	require.NoError(t, key.EncryptKeyFile(accKey, filepath.Join(dir, "account.json"), "secret", ""))
+	require.NoError(t, key.EncryptKeyFile(valKey, filepath.Join(dir, "validator.json"), "secret", ""))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "README"), []byte("not a key"), 0600))
+
+	signers, err := LoadSigners(dir, "secret")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(signers))
+	assert.Equal(t, accKey.Address(), signers[0].Address())
+	assert.Equal(t, valKey.Address(), signers[1].Address())
+
+	_, err = LoadSigners(dir, "wrong")
+	assert.Error(t, err)
+
+	_, err = LoadSigners(filepath.Join(dir, "missing"), "secret")
+	assert.Error(t, err)
+}
@@ -0,0 +1,221 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Level is the access level of a caller. A method can be called if the level
+// of the caller is equal or higher than the level of the method.
+type Level int
+
+const (
+	Public Level = iota
+	User
+	Admin
+)
+
+var (
+	// ErrInvalidCredentials is returned if the API key or the token is not valid
+	ErrInvalidCredentials = errors.New("Invalid credentials")
+)
+
+func ParseLevel(str string) (Level, error) {
+	switch strings.ToLower(str) {
+	case "public":
+		return Public, nil
+	case "user":
+		return User, nil
+	case "admin":
+		return Admin, nil
+	default:
+		return Public, fmt.Errorf("Invalid access level '%s'. It should be 'public', 'user' or 'admin'", str)
+	}
+}
+
+func (l Level) String() string {
+	switch l {
+	case Public:
+		return "public"
+	case User:
+		return "user"
+	case Admin:
+		return "admin"
+	default:
+		return fmt.Sprintf("level(%d)", int(l))
+	}
+}
+
+// Config of the authentication of the RPC servers. If it is enabled, the callers
+// are identified by an API key or a JWT signed by the secret (HS256) which has
+// the access level in its `level` claim.
+type Config struct {
+	Enabled   bool
+	APIKeys   []APIKey
+	JWTSecret string
+	// Methods overrides the access level of the methods, like `gallactic.getAccounts = "user"`
+	Methods map[string]string
+}
+
+type APIKey struct {
+	Key   string
+	Level string
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		Enabled: false,
+	}
+}
+
+// Authenticator authenticates the callers and checks their access to the methods
+type Authenticator struct {
+	enabled bool
+	keys    map[string]Level
+	secret  []byte
+	methods map[string]Level
+}
+
+// NewAuthenticator creates an authenticator. The methods which are not listed in levels are public.
+func NewAuthenticator(conf *Config, levels map[string]Level) (*Authenticator, error) {
+	a := &Authenticator{
+		keys:    make(map[string]Level),
+		methods: make(map[string]Level),
+	}
+	if conf == nil || !conf.Enabled {
+		return a, nil
+	}
+
+	a.enabled = true
+	a.secret = []byte(conf.JWTSecret)
+	for _, k := range conf.APIKeys {
+		if k.Key == "" {
+			return nil, errors.New("API key should not be empty")
+		}
+		level, err := ParseLevel(k.Level)
+		if err != nil {
+			return nil, err
+		}
+		a.keys[k.Key] = level
+	}
+	for m, l := range levels {
+		a.methods[m] = l
+	}
+	for m, str := range conf.Methods {
+		level, err := ParseLevel(str)
+		if err != nil {
+			return nil, err
+		}
+		a.methods[m] = level
+	}
+	return a, nil
+}
+
+func (a *Authenticator) Enabled() bool {
+	return a != nil && a.enabled
+}
+
+// Authenticate returns the access level of the API key or the JWT.
+// Callers without any credentials are public.
+func (a *Authenticator) Authenticate(token string) (Level, error) {
+	if !a.Enabled() {
+		return Admin, nil
+	}
+	if token == "" {
+		return Public, nil
+	}
+	if level, ok := a.keys[token]; ok {
+		return level, nil
+	}
+	if strings.Count(token, ".") == 2 && len(a.secret) > 0 {
+		return a.verifyJWT(token, time.Now())
+	}
+	return Public, ErrInvalidCredentials
+}
+
+// MethodLevel returns the access level which is required to call the method
+func (a *Authenticator) MethodLevel(method string) Level {
+	if !a.Enabled() {
+		return Public
+	}
+	return a.methods[method]
+}
+
+// Authorize checks whether the caller can call the method
+func (a *Authenticator) Authorize(method string, level Level) error {
+	required := a.MethodLevel(method)
+	if level < required {
+		return fmt.Errorf("Method %s requires '%s' access", method, required)
+	}
+	return nil
+}
+
+// TokenFromHeaders returns the credentials from the `X-API-Key` header or
+// the `Authorization: Bearer` header
+func TokenFromHeaders(apiKey, authorization string) string {
+	if apiKey != "" {
+		return apiKey
+	}
+	const bearer = "bearer "
+	if len(authorization) > len(bearer) && strings.ToLower(authorization[:len(bearer)]) == bearer {
+		return strings.TrimSpace(authorization[len(bearer):])
+	}
+	return ""
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+}
+
+type jwtClaims struct {
+	Level     string `json:"level"`
+	ExpiresAt int64  `json:"exp"`
+	NotBefore int64  `json:"nbf"`
+}
+
+func (a *Authenticator) verifyJWT(token string, now time.Time) (Level, error) {
+	parts := strings.Split(token, ".")
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Public, ErrInvalidCredentials
+	}
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return Public, ErrInvalidCredentials
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil || header.Alg != "HS256" {
+		return Public, ErrInvalidCredentials
+	}
+	var claims jwtClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return Public, ErrInvalidCredentials
+	}
+	if claims.ExpiresAt != 0 && now.Unix() >= claims.ExpiresAt {
+		return Public, errors.New("Token is expired")
+	}
+	if claims.NotBefore != 0 && now.Unix() < claims.NotBefore {
+		return Public, errors.New("Token is not valid yet")
+	}
+	level, err := ParseLevel(claims.Level)
+	if err != nil {
+		return Public, ErrInvalidCredentials
+	}
+	return level, nil
+}
+
+func decodeSegment(seg string, v interface{}) error {
+	bs, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bs, v)
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func signJWT(secret, claims string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(claims))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(header + "." + payload))
+	return header + "." + payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestAuthenticator(t *testing.T) {
+	conf := &Config{
+		Enabled:   true,
+		APIKeys:   []APIKey{{Key: "user-key", Level: "user"}, {Key: "admin-key", Level: "admin"}},
+		JWTSecret: "secret",
+		Methods:   map[string]string{"getAccounts": "user"},
+	}
+	a, err := NewAuthenticator(conf, map[string]Level{"broadcastTx": User, "getPeers": Admin, "getAccounts": Public})
+	require.NoError(t, err)
+
+	level, err := a.Authenticate("")
+	require.NoError(t, err)
+	assert.Equal(t, Public, level)
+	level, err = a.Authenticate("user-key")
+	require.NoError(t, err)
+	assert.Equal(t, User, level)
+	_, err = a.Authenticate("unknown-key")
+	assert.Equal(t, ErrInvalidCredentials, err)
+
+	assert.NoError(t, a.Authorize("getStatus", Public))
+	assert.Error(t, a.Authorize("broadcastTx", Public))
+	assert.NoError(t, a.Authorize("broadcastTx", User))
+	assert.Error(t, a.Authorize("getPeers", User))
+	assert.NoError(t, a.Authorize("getPeers", Admin))
+	// Overridden by the config
+	assert.Error(t, a.Authorize("getAccounts", Public))
+
+	// JWT
+	now := time.Now().Unix()
+	level, err = a.Authenticate(signJWT("secret", `{"level":"admin"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Admin, level)
+	_, err = a.Authenticate(signJWT("other", `{"level":"admin"}`))
+	assert.Error(t, err)
+	_, err = a.Authenticate(signJWT("secret", `{"level":"admin","exp":1}`))
+	assert.Error(t, err)
+	_, err = a.Authenticate(signJWT("secret", fmt.Sprintf(`{"level":"admin","nbf":%d}`, now+60)))
+	assert.Error(t, err)
+	level, err = a.Authenticate(signJWT("secret", fmt.Sprintf(`{"level":"user","exp":%d}`, now+60)))
+	require.NoError(t, err)
+	assert.Equal(t, User, level)
+	_, err = a.Authenticate(signJWT("secret", `{"level":"root"}`))
+	assert.Error(t, err)
+}
+
+func TestDisabledAuthenticator(t *testing.T) {
+	a, err := NewAuthenticator(DefaultConfig(), map[string]Level{"getPeers": Admin})
+	require.NoError(t, err)
+	assert.False(t, a.Enabled())
+
+	level, err := a.Authenticate("anything")
+	require.NoError(t, err)
+	assert.NoError(t, a.Authorize("getPeers", level))
+	assert.NoError(t, a.Authorize("getPeers", Public))
+}
+
+func TestInvalidConfig(t *testing.T) {
+	_, err := NewAuthenticator(&Config{Enabled: true, APIKeys: []APIKey{{Key: "k", Level: "root"}}}, nil)
+	assert.Error(t, err)
+	_, err = NewAuthenticator(&Config{Enabled: true, APIKeys: []APIKey{{Key: "", Level: "user"}}}, nil)
+	assert.Error(t, err)
+	_, err = NewAuthenticator(&Config{Enabled: true, Methods: map[string]string{"getPeers": "root"}}, nil)
+	assert.Error(t, err)
+}
+
+func TestTokenFromHeaders(t *testing.T) {
+	assert.Equal(t, "key", TokenFromHeaders("key", "Bearer token"))
+	assert.Equal(t, "token", TokenFromHeaders("", "Bearer token"))
+	assert.Equal(t, "token", TokenFromHeaders("", "bearer  token"))
+	assert.Equal(t, "", TokenFromHeaders("", "Basic dXNlcjpwYXNz"))
+	assert.Equal(t, "", TokenFromHeaders("", ""))
+}
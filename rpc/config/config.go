@@ -2,7 +2,9 @@ package config
 
 type RPCConfig struct {
 	Enabled bool
-	Server  *ServerConfig
+	// Admin enables the admin methods, like changing the log levels at runtime
+	Admin  bool
+	Server *ServerConfig
 }
 
 func DefaultRPCConfig() *RPCConfig {
@@ -14,10 +16,12 @@ func DefaultRPCConfig() *RPCConfig {
 
 type (
 	ServerConfig struct {
-		Bind Bind `toml:"bind"`
-		TLS  TLS  `toml:"TLS"`
-		CORS CORS `toml:"CORS"`
-		HTTP HTTP `toml:"HTTP"`
+		Bind      Bind      `toml:"bind"`
+		TLS       TLS       `toml:"TLS"`
+		CORS      CORS      `toml:"CORS"`
+		HTTP      HTTP      `toml:"HTTP"`
+		WebSocket WebSocket `toml:"WebSocket"`
+		Health    Health    `toml:"Health"`
 	}
 
 	Bind struct {
@@ -45,6 +49,25 @@ type (
 	HTTP struct {
 		JsonRpcEndpoint string `toml:"json_rpc_endpoint"`
 	}
+
+	// WebSocket serves the JSON-RPC methods and the subscriptions over WebSocket
+	WebSocket struct {
+		Enable           bool   `toml:"enable"`
+		Endpoint         string `toml:"endpoint"`
+		MaxSessions      int    `toml:"max_sessions"`
+		MaxSubscriptions int    `toml:"max_subscriptions"`
+	}
+
+	// Health serves the health and the readiness of the node. The node is ready if it is not catching up,
+	// has enough peers, its last block is recent enough and it is in the validator set, if it is required.
+	Health struct {
+		Enable           bool   `toml:"enable"`
+		HealthEndpoint   string `toml:"health_endpoint"`
+		ReadyEndpoint    string `toml:"ready_endpoint"`
+		MinPeers         int    `toml:"min_peers"`
+		MaxBlockAge      uint64 `toml:"max_block_age"` // In seconds, zero means no limit
+		RequireValidator bool   `toml:"require_validator"`
+	}
 )
 
 func DefaultServerConfig() *ServerConfig {
@@ -63,5 +86,17 @@ func DefaultServerConfig() *ServerConfig {
 		HTTP: HTTP{
 			JsonRpcEndpoint: "/rpc",
 		},
+		WebSocket: WebSocket{
+			Enable:           true,
+			Endpoint:         "/ws",
+			MaxSessions:      100,
+			MaxSubscriptions: 10,
+		},
+		Health: Health{
+			Enable:         true,
+			HealthEndpoint: "/health",
+			ReadyEndpoint:  "/ready",
+			MaxBlockAge:    60,
+		},
 	}
 }
@@ -38,10 +38,13 @@ type ConfigurableFilter interface {
 // Op can be any of the following:
 // The usual relative operators: <, >, <=, >=, ==, != (where applicable)
 // A range parameter (see: https://help.github.com/articles/search-syntax/)
+// If Or is set, the field, op and value are ignored and the filter matches if
+// all the filters of any of the groups match.
 type FilterData struct {
-	Field string `json:"field"`
-	Op    string `json:"op"`
-	Value string `json:"value"`
+	Field string          `json:"field"`
+	Op    string          `json:"op"`
+	Value string          `json:"value"`
+	Or    [][]*FilterData `json:"or,omitempty"`
 }
 
 // Filter made up of many filters.
@@ -62,6 +65,20 @@ func (cf *CompositeFilter) Match(v interface{}) bool {
 	return true
 }
 
+// Filter that matches if any of its filters matches.
+type AnyFilter struct {
+	filters []Filter
+}
+
+func (af *AnyFilter) Match(v interface{}) bool {
+	for _, f := range af.filters {
+		if f.Match(v) {
+			return true
+		}
+	}
+	return false
+}
+
 // Rubberstamps everything.
 type MatchAllFilter struct{}
 
@@ -119,6 +136,20 @@ func GetRangeFilter(op, fName string) (func(a, b uint64) bool, error) {
 	}
 }
 
+func GetBoolFilter(op, fName string) (func(a, b bool) bool, error) {
+	if op == "==" {
+		return func(a, b bool) bool {
+			return a == b
+		}, nil
+	} else if op == "!=" {
+		return func(a, b bool) bool {
+			return a != b
+		}, nil
+	} else {
+		return nil, fmt.Errorf("Op: " + op + " is not supported for '" + fName + "' filtering")
+	}
+}
+
 func GetStringFilter(op, fName string) (func(s0, s1 string) bool, error) {
 	if op == "==" {
 		return func(s0, s1 string) bool {
@@ -64,7 +64,10 @@ func (ff *FilterFactory) NewFilter(fdArr []*FilterData) (Filter, error) {
 	return cf, nil
 }
 
-func (ff *FilterFactory) newSingleFilter(fd *FilterData) (ConfigurableFilter, error) {
+func (ff *FilterFactory) newSingleFilter(fd *FilterData) (Filter, error) {
+	if len(fd.Or) > 0 {
+		return ff.newAnyFilter(fd.Or)
+	}
 	fp, ok := ff.filterPools[strings.ToLower(fd.Field)]
 	if !ok {
 		return nil, fmt.Errorf("Field is not supported: " + fd.Field)
@@ -76,3 +79,17 @@ func (ff *FilterFactory) newSingleFilter(fd *FilterData) (ConfigurableFilter, er
 	}
 	return f, nil
 }
+
+// Creates a filter which matches if any of the groups matches. The filters of each group
+// are combined like in NewFilter.
+func (ff *FilterFactory) newAnyFilter(groups [][]*FilterData) (Filter, error) {
+	filters := []Filter{}
+	for _, group := range groups {
+		f, err := ff.NewFilter(group)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+	return &AnyFilter{filters: filters}, nil
+}
@@ -5,20 +5,27 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/gallactic/gallactic/core/blockchain"
 
 	"github.com/gallactic/gallactic/common/binary"
 	"github.com/gallactic/gallactic/core/account"
 
+	"github.com/gallactic/gallactic/core/consensus/tendermint/codes"
 	"github.com/gallactic/gallactic/core/consensus/tendermint/p2p"
 	"github.com/gallactic/gallactic/core/consensus/tendermint/query"
+	"github.com/gallactic/gallactic/core/events"
+	"github.com/gallactic/gallactic/core/execution"
+	"github.com/gallactic/gallactic/core/indexer"
 	"github.com/gallactic/gallactic/core/state"
 	"github.com/gallactic/gallactic/core/validator"
 	"github.com/gallactic/gallactic/crypto"
+	"github.com/gallactic/gallactic/rpc"
 	pb "github.com/gallactic/gallactic/rpc/grpc/proto3"
 	"github.com/gallactic/gallactic/txs"
 	"github.com/gallactic/gallactic/version"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
 	consensusTypes "github.com/tendermint/tendermint/consensus/types"
 	net "github.com/tendermint/tendermint/p2p"
 	tmRPC "github.com/tendermint/tendermint/rpc/core"
@@ -26,13 +33,15 @@ import (
 	tmTypes "github.com/tendermint/tendermint/types"
 )
 
-// MaxBlockLookback constant
-const MaxBlockLookback = 1000
-
 type blockchainService struct {
-	nodeview   *query.NodeView
-	blockchain *blockchain.Blockchain
-	state      *state.State
+	nodeview             *query.NodeView
+	blockchain           *blockchain.Blockchain
+	state                *state.State
+	accountFilterFactory *rpc.FilterFactory
+	txIndexer            *indexer.TxIndexer
+	eventBus             events.EventBus
+	mempoolAccounts      execution.MempoolAccounts
+	maxPageLimit         int
 }
 
 var _ pb.BlockChainServer = &blockchainService{}
@@ -41,11 +50,17 @@ func (s *blockchainService) State() *state.State {
 	return s.state
 }
 
-func NewBlockchainService(blockchain *blockchain.Blockchain, nview *query.NodeView) *blockchainService {
+func NewBlockchainService(blockchain *blockchain.Blockchain, txIndexer *indexer.TxIndexer, eventBus events.EventBus,
+	mempoolAccounts execution.MempoolAccounts, maxPageLimit int, nview *query.NodeView) *blockchainService {
 	return &blockchainService{
-		blockchain: blockchain,
-		nodeview:   nview,
-		state:      blockchain.State(),
+		blockchain:           blockchain,
+		nodeview:             nview,
+		state:                blockchain.State(),
+		accountFilterFactory: rpc.NewAccountFilterFactory(blockchain.State()),
+		txIndexer:            txIndexer,
+		eventBus:             eventBus,
+		mempoolAccounts:      mempoolAccounts,
+		maxPageLimit:         maxPageLimit,
 	}
 }
 
@@ -62,17 +77,44 @@ func (as *blockchainService) GetAccount(ctx context.Context, param *pb.AddressRe
 	return &pb.AccountResponse{Account: acc}, nil
 }
 
-func (as *blockchainService) GetAccounts(ctx context.Context, in *pb.Empty) (*pb.AccountsResponse, error) {
-	accounts := make([]*pb.AccountResponse, 0)
-	as.state.IterateAccounts(func(acc *account.Account) (stop bool) {
-		if acc != nil {
-			accounts = append(accounts, &pb.AccountResponse{Account: acc})
-		}
-		return false
+// GetMempoolAccount returns the account with the changes of the transactions in the mempool
+func (as *blockchainService) GetMempoolAccount(ctx context.Context, param *pb.AddressRequest) (*pb.AccountResponse, error) {
+	addr, err := crypto.AddressFromString(param.Address)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := as.mempoolAccounts.GetAccount(addr)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AccountResponse{Account: acc}, nil
+}
+
+func (as *blockchainService) GetAccounts(ctx context.Context, in *pb.AccountsRequest) (*pb.AccountsResponse, error) {
+	page, err := rpc.NewPage(int(in.Limit), in.Order, false, as.maxPageLimit)
+	if err != nil {
+		return nil, err
+	}
+	from, err := rpc.AddressCursor(in.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := as.accountFilterFactory.NewFilter(toFilterData(in.Filters))
+	if err != nil {
+		return nil, err
+	}
+
+	list, nextCursor := rpc.ScanAccounts(as.state, from, page, func(acc *account.Account) bool {
+		return filter.Match(acc)
 	})
+	accounts := make([]*pb.AccountResponse, 0, len(list))
+	for _, acc := range list {
+		accounts = append(accounts, &pb.AccountResponse{Account: acc})
+	}
 	return &pb.AccountsResponse{
 		BlockHeight: as.blockchain.LastBlockHeight(),
 		Accounts:    accounts,
+		NextCursor:  nextCursor,
 	}, nil
 }
 
@@ -89,19 +131,75 @@ func (vs *blockchainService) GetValidator(ctx context.Context, param *pb.Address
 	return &pb.ValidatorResponse{Validator: pbval}, nil
 }
 
-func (vs *blockchainService) GetValidators(context.Context, *pb.Empty) (*pb.ValidatorsResponse, error) {
-	validators := make([]*pb.ValidatorInfo, 0)
+func (vs *blockchainService) GetValidators(ctx context.Context, in *pb.ValidatorsRequest) (*pb.ValidatorsResponse, error) {
+	page, err := rpc.NewPage(int(in.Page.GetLimit()), in.Page.GetOrder(), false, vs.maxPageLimit)
+	if err != nil {
+		return nil, err
+	}
+	from, err := rpc.AddressCursor(in.Page.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+	match, err := rpc.ValidatorStatusFilter(in.Status, vs.blockchain.LastValidators())
+	if err != nil {
+		return nil, err
+	}
 
-	vs.state.IterateValidators(func(val *validator.Validator) (stop bool) {
-		if val != nil {
-			pbval := vs.toValidator(val)
-			validators = append(validators, pbval)
+	validators := make([]*pb.ValidatorInfo, 0)
+	nextCursor := ""
+	vs.state.IterateValidatorsFrom(from, page.Reverse, func(val *validator.Validator) (stop bool) {
+		if val == nil || !match(val) {
+			return false
+		}
+		if len(validators) == page.Limit {
+			nextCursor = val.Address().String()
+			return true
 		}
+		validators = append(validators, vs.toValidator(val))
 		return false
 	})
 	return &pb.ValidatorsResponse{
 		Validators:  validators,
 		BlockHeight: vs.blockchain.LastBlockHeight(),
+		NextCursor:  nextCursor,
+	}, nil
+}
+
+func (vs *blockchainService) GetValidatorSet(ctx context.Context, in *pb.BlockRequest) (*pb.ValidatorSetResponse, error) {
+	rec, err := vs.blockchain.ValidatorSetAt(in.Height)
+	if err != nil {
+		return nil, err
+	}
+	toStrings := func(addrs []crypto.Address) []string {
+		strs := make([]string, len(addrs))
+		for i, addr := range addrs {
+			strs[i] = addr.String()
+		}
+		return strs
+	}
+	return &pb.ValidatorSetResponse{
+		Height:     rec.Height,
+		Validators: toStrings(rec.Validators),
+		Leavers:    toStrings(rec.Leavers),
+	}, nil
+}
+
+func (vs *blockchainService) GetValidatorHistory(ctx context.Context, param *pb.AddressRequest) (*pb.ValidatorHistoryResponse, error) {
+	addr, err := crypto.AddressFromString(param.Address)
+	if err != nil {
+		return nil, err
+	}
+	events, err := vs.blockchain.ValidatorHistory(addr)
+	if err != nil {
+		return nil, err
+	}
+	pbEvents := make([]*pb.ValidatorEvent, len(events))
+	for i, event := range events {
+		pbEvents[i] = &pb.ValidatorEvent{Height: event.Height, Type: event.Type}
+	}
+	return &pb.ValidatorHistoryResponse{
+		Address: addr.String(),
+		Events:  pbEvents,
 	}, nil
 }
 
@@ -186,6 +284,15 @@ func (s *blockchainService) GetBlock(ctx context.Context, req *pb.BlockRequest)
 }
 
 func (s *blockchainService) GetBlocks(ctx context.Context, blocks *pb.BlocksRequest) (*pb.BlocksResponse, error) {
+	page, err := rpc.NewPage(int(blocks.Page.GetLimit()), blocks.Page.GetOrder(), true, s.maxPageLimit)
+	if err != nil {
+		return nil, err
+	}
+	from, err := rpc.HeightCursor(blocks.Page.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
 	latestHeight := s.blockchain.LastBlockHeight()
 	if blocks.MinHeight == 0 {
 		blocks.MinHeight = 1
@@ -193,21 +300,64 @@ func (s *blockchainService) GetBlocks(ctx context.Context, blocks *pb.BlocksRequ
 	if blocks.MaxHeight == 0 || latestHeight < blocks.MaxHeight {
 		blocks.MaxHeight = latestHeight
 	}
-	if blocks.MaxHeight > blocks.MinHeight && blocks.MaxHeight-blocks.MinHeight > MaxBlockLookback {
-		blocks.MinHeight = blocks.MaxHeight - MaxBlockLookback
-	}
-	var pbBlocks []pb.BlockInfo
-	for height := blocks.MaxHeight; height >= blocks.MinHeight; height-- {
-		bl, _ := s.getBlockdetails(int64(height))
-		pbBlocks = append(pbBlocks, *bl)
 
+	pbBlocks := make([]pb.BlockInfo, 0)
+	nextCursor := ""
+	if page.Reverse {
+		if from != 0 && from < blocks.MaxHeight {
+			blocks.MaxHeight = from
+		}
+		height := blocks.MaxHeight
+		for ; height >= blocks.MinHeight && len(pbBlocks) < page.Limit; height-- {
+			bl, _ := s.getBlockdetails(int64(height))
+			pbBlocks = append(pbBlocks, *bl)
+		}
+		if height >= blocks.MinHeight {
+			nextCursor = strconv.FormatUint(height, 10)
+		}
+	} else {
+		if from > blocks.MinHeight {
+			blocks.MinHeight = from
+		}
+		height := blocks.MinHeight
+		for ; height <= blocks.MaxHeight && len(pbBlocks) < page.Limit; height++ {
+			bl, _ := s.getBlockdetails(int64(height))
+			pbBlocks = append(pbBlocks, *bl)
+		}
+		if height <= blocks.MaxHeight {
+			nextCursor = strconv.FormatUint(height, 10)
+		}
 	}
 	return &pb.BlocksResponse{
-		Blocks: pbBlocks,
+		Blocks:     pbBlocks,
+		NextCursor: nextCursor,
 	}, nil
 
 }
 
+// SubscribeBlocks streams the committed blocks. The blocks from FromHeight are replayed
+// first, so a reconnecting client receives the blocks it missed.
+func (s *blockchainService) SubscribeBlocks(req *pb.SubscribeBlocksRequest, stream pb.BlockChain_SubscribeBlocksServer) error {
+	return followBlocks(stream.Context(), s.eventBus, s.blockchain.LastBlockHeight, req.FromHeight, func(height int64) error {
+		bl, err := s.getBlockdetails(height)
+		if err != nil {
+			return err
+		}
+		return stream.Send(bl)
+	})
+}
+
+// SubscribeHeaders streams the headers of the committed blocks. The headers from FromHeight are replayed first.
+func (s *blockchainService) SubscribeHeaders(req *pb.SubscribeBlocksRequest, stream pb.BlockChain_SubscribeHeadersServer) error {
+	return followBlocks(stream.Context(), s.eventBus, s.blockchain.LastBlockHeight, req.FromHeight, func(height int64) error {
+		header, err := s.getHeaderdetails(height)
+		if err != nil {
+			return err
+		}
+		return stream.Send(header)
+	})
+}
+
 func (s *blockchainService) GetGenesis(context.Context, *pb.Empty) (*pb.GenesisResponse, error) {
 	gen := s.blockchain.Genesis()
 	return &pb.GenesisResponse{
@@ -290,49 +440,133 @@ func (s *blockchainService) GetTx(ctx context.Context, req *pb.TxRequest) (*pb.T
 
 }
 
+func (s *blockchainService) GetAccountTxs(ctx context.Context, req *pb.AccountTxsRequest) (*pb.AccountTxsResponse, error) {
+	addr, err := crypto.AddressFromString(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	page, err := rpc.NewPage(int(req.Page.GetLimit()), req.Page.GetOrder(), true, s.maxPageLimit)
+	if err != nil {
+		return nil, err
+	}
+	from, err := rpc.TxCursor(req.Page.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	list, next, err := s.txIndexer.AccountTxs(addr, req.FromHeight, req.ToHeight, from, page.Limit, page.Reverse)
+	if err != nil {
+		return nil, err
+	}
+	pbTxs := make([]*pb.AccountTx, len(list))
+	for i, atx := range list {
+		pbTxs[i] = &pb.AccountTx{
+			Height: atx.Height,
+			Index:  atx.Index,
+			Hash:   hex.EncodeToString(atx.Hash),
+			Type:   atx.Type.String(),
+			Status: int32(atx.Status),
+		}
+	}
+	nextCursor := ""
+	if next != nil {
+		nextCursor = next.String()
+	}
+	return &pb.AccountTxsResponse{
+		Address:    addr.String(),
+		Txs:        pbTxs,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// Converts the filters of the request to the filters of the account filter factory
+func toFilterData(filters []*pb.FilterData) []*rpc.FilterData {
+	fds := make([]*rpc.FilterData, 0, len(filters))
+	for _, f := range filters {
+		fd := &rpc.FilterData{
+			Field: f.Field,
+			Op:    f.Op,
+			Value: f.Value,
+		}
+		for _, group := range f.Or {
+			fd.Or = append(fd.Or, toFilterData(group.Filters))
+		}
+		fds = append(fds, fd)
+	}
+	return fds
+}
+
 //Get validator
 func (vs *blockchainService) toValidator(val *validator.Validator) *pb.ValidatorInfo {
 	return &pb.ValidatorInfo{
-		Address: val.Address().String(),
-		PubKey:  val.PublicKey().String(),
-		Power:   val.Power(),
-		Stake:   val.Stake(),
+		Address:       val.Address().String(),
+		PubKey:        val.PublicKey().String(),
+		Power:         val.Power(),
+		Stake:         val.Stake(),
+		BondingHeight: val.BondingHeight(),
+		Sequence:      val.Sequence(),
 	}
 }
 
-//Get Block and Blockmeta
-func (s *blockchainService) getBlockdetails(blockheight int64) (*pb.BlockInfo, error) {
-
+// Get the header of the block
+func (s *blockchainService) getHeaderdetails(blockheight int64) (*pb.HeaderInfo, error) {
 	blockmeta := s.nodeview.BlockStore().LoadBlockMeta(blockheight)
-	block := s.nodeview.BlockStore().LoadBlock(blockheight)
-	if blockmeta == nil || block == nil {
+	if blockmeta == nil {
 		return nil, fmt.Errorf("Invalid blockheight")
 	}
-	var pbBlock pb.BlockInfo
-	pbBlock.Header.BlockHash = blockmeta.BlockID.Hash.Bytes()
-	pbBlock.Header.Time = blockmeta.Header.Time
-	pbBlock.Header.TotalTxs = blockmeta.Header.TotalTxs
-	pbBlock.Header.Version.App = blockmeta.Header.Version.App.Uint64()
-	pbBlock.Header.Version.Block = blockmeta.Header.Version.Block.Uint64()
-	pbBlock.Header.ChainID = blockmeta.Header.ChainID
-	pbBlock.Header.Height = blockmeta.Header.Height
-	pbBlock.Header.NumTxs = blockmeta.Header.NumTxs
-	pbBlock.Header.LastBlockId = blockmeta.Header.LastBlockID.Hash // ignoring PartSetHeader
-	pbBlock.Header.LastCommitHash = blockmeta.Header.LastCommitHash.Bytes()
-	pbBlock.Header.DataHash = blockmeta.Header.DataHash.Bytes()
-	pbBlock.Header.ValidatorsHash = blockmeta.Header.ValidatorsHash.Bytes()
-	pbBlock.Header.NextValidatorsHash = blockmeta.Header.NextValidatorsHash.Bytes()
-	pbBlock.Header.ConsensusHash = blockmeta.Header.ConsensusHash.Bytes()
-	pbBlock.Header.AppHash = blockmeta.Header.AppHash.Bytes()
-	pbBlock.Header.LastResultsHash = blockmeta.Header.LastResultsHash.Bytes()
-	pbBlock.Header.EvidenceHash = blockmeta.Header.EvidenceHash.Bytes()
+	var header pb.HeaderInfo
+	header.BlockHash = blockmeta.BlockID.Hash.Bytes()
+	header.Time = blockmeta.Header.Time
+	header.TotalTxs = blockmeta.Header.TotalTxs
+	header.Version.App = blockmeta.Header.Version.App.Uint64()
+	header.Version.Block = blockmeta.Header.Version.Block.Uint64()
+	header.ChainID = blockmeta.Header.ChainID
+	header.Height = blockmeta.Header.Height
+	header.NumTxs = blockmeta.Header.NumTxs
+	header.LastBlockId = blockmeta.Header.LastBlockID.Hash // ignoring PartSetHeader
+	header.LastCommitHash = blockmeta.Header.LastCommitHash.Bytes()
+	header.DataHash = blockmeta.Header.DataHash.Bytes()
+	header.ValidatorsHash = blockmeta.Header.ValidatorsHash.Bytes()
+	header.NextValidatorsHash = blockmeta.Header.NextValidatorsHash.Bytes()
+	header.ConsensusHash = blockmeta.Header.ConsensusHash.Bytes()
+	header.AppHash = blockmeta.Header.AppHash.Bytes()
+	header.LastResultsHash = blockmeta.Header.LastResultsHash.Bytes()
+	header.EvidenceHash = blockmeta.Header.EvidenceHash.Bytes()
 	valadrr, err := crypto.ValidatorAddress(blockmeta.Header.ProposerAddress)
 	if err != nil {
 		return nil, err
 	}
-	pbBlock.Header.ProposerAddress = valadrr.String()
+	header.ProposerAddress = valadrr.String()
+	return &header, nil
+}
 
-	for _, _tx := range block.Data.Txs {
+func txStatus(code uint32) int32 {
+	if code != codes.TxExecutionSuccessCode {
+		return txs.Failed
+	}
+	return txs.Ok
+}
+
+//Get Block and Blockmeta
+func (s *blockchainService) getBlockdetails(blockheight int64) (*pb.BlockInfo, error) {
+	header, err := s.getHeaderdetails(blockheight)
+	if err != nil {
+		return nil, err
+	}
+	block := s.nodeview.BlockStore().LoadBlock(blockheight)
+	if block == nil {
+		return nil, fmt.Errorf("Invalid blockheight")
+	}
+	// The receipts of the transactions, if the results of the block are kept
+	var deliverTxs []*abciTypes.ResponseDeliverTx
+	if results, err := tmRPC.BlockResults(&blockheight); err == nil && results.Results != nil {
+		deliverTxs = results.Results.DeliverTx
+	}
+
+	var pbBlock pb.BlockInfo
+	pbBlock.Header = *header
+
+	for i, _tx := range block.Data.Txs {
 		var tx pb.TxInfo
 		var env txs.Envelope
 		err := env.Decode(_tx)
@@ -341,9 +575,15 @@ func (s *blockchainService) getBlockdetails(blockheight int64) (*pb.BlockInfo, e
 		}
 		js, _ := json.Marshal(env)
 
+		tx.Height = blockheight
 		tx.Hash = hex.EncodeToString(_tx.Hash())
 
 		tx.Envelope = string(js)
+		if i < len(deliverTxs) && deliverTxs[i] != nil {
+			tx.GasUsed = deliverTxs[i].GasUsed
+			tx.GasWanted = deliverTxs[i].GasWanted
+			tx.Status = txStatus(deliverTxs[i].Code)
+		}
 		pbBlock.Txs = append(pbBlock.Txs, tx)
 	}
 	pbBlock.LastCommitInfo.BlockHash = block.LastCommit.BlockID.Hash.Bytes()
@@ -393,6 +633,7 @@ func (s *blockchainService) getTx(_tx *tmRPCTypes.ResultTx) *pb.TxInfo {
 	tx.Hash = _tx.Hash.String()
 	tx.GasUsed = _tx.TxResult.GasUsed
 	tx.GasWanted = _tx.TxResult.GasWanted
+	tx.Status = txStatus(_tx.TxResult.Code)
 	tx.Envelope = string(js)
 	return tx
 }
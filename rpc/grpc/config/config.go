@@ -10,6 +10,23 @@ type GRPCConfig struct {
 	Enabled       bool
 	ListenAddress string
 	HTTPAddress   string
+	TLS           TLS
+}
+
+// TLS secures the gRPC server and the gateway. If ClientCAFile is set, the clients
+// should have a certificate which is signed by it (mutual TLS).
+type TLS struct {
+	Enabled      bool
+	CertFile     string
+	KeyFile      string
+	ClientCAFile string
+	// CAFile is the certificate authority of the server which the gateway trusts.
+	// The server certificate itself is trusted if it is not set.
+	CAFile string
+	// GatewayCertFile and GatewayKeyFile are the client certificate of the gateway
+	// for mutual TLS. The server certificate is used if they are not set.
+	GatewayCertFile string
+	GatewayKeyFile  string
 }
 
 func DefaultGRPCConfig() *GRPCConfig {
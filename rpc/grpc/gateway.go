@@ -12,14 +12,26 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/tmc/grpc-websocket-proxy/wsproxy"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 )
 
 func (s *Server) StartGateway(ctx context.Context, grpcAddr, gatewayAddr string) error {
 
 	getEndpoint := flag.String("get", grpcAddr, "endpoint of Gallactic(GET)")
 
-	mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONBuiltin{}))
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONBuiltin{}),
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+		runtime.WithMetadata(s.gatewayMetadata))
 	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if s.tls.Enabled {
+		clientTLS, err := gatewayTLSConfig(s.tls, *getEndpoint)
+		if err != nil {
+			return err
+		}
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(clientTLS))}
+	}
 
 	if err := pb.RegisterBlockChainHandlerFromEndpoint(ctx, mux, *getEndpoint, opts); err != nil {
 		return err
@@ -36,13 +48,60 @@ func (s *Server) StartGateway(ctx context.Context, grpcAddr, gatewayAddr string)
 	s.handleEntryPoint(mux, gatewayAddr)
 
 	/// TODO: Make it configurable
-	h := allowCORS(wsproxy.WebsocketProxy(mux))
-
-	go http.ListenAndServe(gatewayAddr, h) /// TODO: check error with channels
+	h := s.gatewayClientMW(allowCORS(wsproxy.WebsocketProxy(mux)))
+
+	srv := &http.Server{Addr: gatewayAddr, Handler: h}
+	if s.tls.Enabled {
+		// The gateway is served by the same certificate and verifies the clients the same as the gRPC server
+		serverTLS, err := serverTLSConfig(s.tls)
+		if err != nil {
+			return err
+		}
+		srv.TLSConfig = serverTLS
+		go srv.ListenAndServeTLS("", "") /// TODO: check error with channels
+	} else {
+		go srv.ListenAndServe() /// TODO: check error with channels
+	}
 
 	return nil
 }
 
+// The gateway passes the IP address of its client to the gRPC server by these metadata.
+// The token proves to the server that the metadata is set by its own gateway.
+const (
+	gatewayTokenKey    = "x-gallactic-gateway"
+	gatewayClientIPKey = "x-gallactic-client-ip"
+)
+
+type gatewayClientKey struct{}
+
+// gatewayClientMW keeps the IP address of the client in the request context,
+// the websocket proxy doesn't keep the remote address of the request.
+func (s *Server) gatewayClientMW(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := s.limiter.ClientIP(r.RemoteAddr, r.Header.Get("X-Forwarded-For"))
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), gatewayClientKey{}, ip)))
+	})
+}
+
+func (s *Server) gatewayMetadata(ctx context.Context, req *http.Request) metadata.MD {
+	ip, _ := req.Context().Value(gatewayClientKey{}).(string)
+	return metadata.Pairs(gatewayTokenKey, s.gatewayToken, gatewayClientIPKey, ip)
+}
+
+// headerMatcher passes the API key to the gRPC server, beside the default headers.
+// The clients can't set the metadata of the gateway.
+func headerMatcher(key string) (string, bool) {
+	key = http.CanonicalHeaderKey(key)
+	if key == "X-Api-Key" {
+		return "x-api-key", true
+	}
+	if strings.HasPrefix(key, runtime.MetadataHeaderPrefix+"X-Gallactic-") {
+		return "", false
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 func (s *Server) handleEntryPoint(mux *runtime.ServeMux, addr string) {
 	entryPoint := runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{""}, ""))
 	// grpc endpoints
@@ -88,7 +147,7 @@ func allowCORS(h http.Handler) http.Handler {
 // CORS from any origin using the methods "GET", "HEAD", "POST", "PUT", "DELETE"
 // We insist, don't do this without consideration in production systems.
 func preflightHandler(w http.ResponseWriter, r *http.Request) {
-	headers := []string{"Content-Type", "Accept"}
+	headers := []string{"Content-Type", "Accept", "Authorization", "X-API-Key"}
 	w.Header().Set("Access-Control-Allow-Headers", strings.Join(headers, ","))
 	methods := []string{"GET", "HEAD", "POST", "PUT", "DELETE"}
 	w.Header().Set("Access-Control-Allow-Methods", strings.Join(methods, ","))
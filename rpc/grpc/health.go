@@ -0,0 +1,45 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Interval of checking whether the node is syncing
+const healthCheckInterval = time.Second
+
+// SetServing sets the status of the node and of all the registered services in the health checking service
+func (s *Server) SetServing(serving bool) {
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+	s.health.SetServingStatus("", status)
+	for name := range s.GetServiceInfo() {
+		s.health.SetServingStatus(name, status)
+	}
+}
+
+// WatchHealth updates the health status until the context is done. The node is serving if it is not syncing.
+func (s *Server) WatchHealth(ctx context.Context, syncing func() bool) {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+
+	serving := false
+	s.SetServing(serving)
+	for {
+		if isServing := !syncing(); isServing != serving {
+			serving = isServing
+			logger.Info("GRPC health status changed", "serving", serving)
+			s.SetServing(serving)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gallactic/gallactic/rpc/grpc/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+func TestHealthAndReflection(t *testing.T) {
+	s, err := NewGRPCServer(config.TLS{}, nil, nil)
+	require.NoError(t, err)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go s.Serve(lis)
+	defer s.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	client := healthpb.NewHealthClient(conn)
+	status := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		res, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		return res.Status
+	}
+
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(""))
+
+	var syncing int32 = 1
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.WatchHealth(ctx, func() bool { return atomic.LoadInt32(&syncing) == 1 })
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(""))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status("grpc.health.v1.Health"))
+
+	atomic.StoreInt32(&syncing, 0)
+	time.Sleep(healthCheckInterval + 100*time.Millisecond)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, status(""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, status("grpc.health.v1.Health"))
+
+	// Reflection lists the services
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}))
+	res, err := stream.Recv()
+	require.NoError(t, err)
+	var names []string
+	for _, svc := range res.GetListServicesResponse().Service {
+		names = append(names, svc.Name)
+	}
+	assert.Contains(t, names, "grpc.health.v1.Health")
+	assert.Contains(t, names, "grpc.reflection.v1alpha.ServerReflection")
+}
@@ -49,7 +49,7 @@ func (m *Empty) Reset()         { *m = Empty{} }
 func (m *Empty) String() string { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()    {}
 func (*Empty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{0}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{0}
 }
 func (m *Empty) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Empty.Unmarshal(m, b)
@@ -84,7 +84,7 @@ func (m *AddressRequest) Reset()         { *m = AddressRequest{} }
 func (m *AddressRequest) String() string { return proto.CompactTextString(m) }
 func (*AddressRequest) ProtoMessage()    {}
 func (*AddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{1}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{1}
 }
 func (m *AddressRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddressRequest.Unmarshal(m, b)
@@ -126,7 +126,7 @@ func (m *AccountResponse) Reset()         { *m = AccountResponse{} }
 func (m *AccountResponse) String() string { return proto.CompactTextString(m) }
 func (*AccountResponse) ProtoMessage()    {}
 func (*AccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{2}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{2}
 }
 func (m *AccountResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountResponse.Unmarshal(m, b)
@@ -150,9 +150,247 @@ func (*AccountResponse) XXX_MessageName() string {
 	return "proto3.AccountResponse"
 }
 
+// PageRequest selects a page of a listing. Cursor is the NextCursor of the
+// previous page and Order is "asc" or "desc".
+type PageRequest struct {
+	Cursor               string   `protobuf:"bytes,1,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
+	Limit                int32    `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Order                string   `protobuf:"bytes,3,opt,name=Order,proto3" json:"Order,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PageRequest) Reset()         { *m = PageRequest{} }
+func (m *PageRequest) String() string { return proto.CompactTextString(m) }
+func (*PageRequest) ProtoMessage()    {}
+func (*PageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{3}
+}
+func (m *PageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PageRequest.Unmarshal(m, b)
+}
+func (m *PageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PageRequest.Marshal(b, m, deterministic)
+}
+func (dst *PageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PageRequest.Merge(dst, src)
+}
+func (m *PageRequest) XXX_Size() int {
+	return xxx_messageInfo_PageRequest.Size(m)
+}
+func (m *PageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PageRequest proto.InternalMessageInfo
+
+func (m *PageRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *PageRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *PageRequest) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+func (*PageRequest) XXX_MessageName() string {
+	return "proto3.PageRequest"
+}
+
+// FilterData filters the accounts by field, e.g. balance > 100. If Or is set, the
+// filter matches if all the filters of any of the groups match.
+type FilterData struct {
+	Field                string         `protobuf:"bytes,1,opt,name=Field,proto3" json:"Field,omitempty"`
+	Op                   string         `protobuf:"bytes,2,opt,name=Op,proto3" json:"Op,omitempty"`
+	Value                string         `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
+	Or                   []*FilterGroup `protobuf:"bytes,4,rep,name=Or" json:"Or,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *FilterData) Reset()         { *m = FilterData{} }
+func (m *FilterData) String() string { return proto.CompactTextString(m) }
+func (*FilterData) ProtoMessage()    {}
+func (*FilterData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{4}
+}
+func (m *FilterData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilterData.Unmarshal(m, b)
+}
+func (m *FilterData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilterData.Marshal(b, m, deterministic)
+}
+func (dst *FilterData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilterData.Merge(dst, src)
+}
+func (m *FilterData) XXX_Size() int {
+	return xxx_messageInfo_FilterData.Size(m)
+}
+func (m *FilterData) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilterData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilterData proto.InternalMessageInfo
+
+func (m *FilterData) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *FilterData) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *FilterData) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *FilterData) GetOr() []*FilterGroup {
+	if m != nil {
+		return m.Or
+	}
+	return nil
+}
+
+func (*FilterData) XXX_MessageName() string {
+	return "proto3.FilterData"
+}
+
+type FilterGroup struct {
+	Filters              []*FilterData `protobuf:"bytes,1,rep,name=Filters" json:"Filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *FilterGroup) Reset()         { *m = FilterGroup{} }
+func (m *FilterGroup) String() string { return proto.CompactTextString(m) }
+func (*FilterGroup) ProtoMessage()    {}
+func (*FilterGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{5}
+}
+func (m *FilterGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilterGroup.Unmarshal(m, b)
+}
+func (m *FilterGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilterGroup.Marshal(b, m, deterministic)
+}
+func (dst *FilterGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilterGroup.Merge(dst, src)
+}
+func (m *FilterGroup) XXX_Size() int {
+	return xxx_messageInfo_FilterGroup.Size(m)
+}
+func (m *FilterGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilterGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilterGroup proto.InternalMessageInfo
+
+func (m *FilterGroup) GetFilters() []*FilterData {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (*FilterGroup) XXX_MessageName() string {
+	return "proto3.FilterGroup"
+}
+
+// AccountsRequest has the fields of PageRequest, so they stay query parameters of the gateway
+type AccountsRequest struct {
+	Cursor               string        `protobuf:"bytes,1,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
+	Limit                int32         `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Order                string        `protobuf:"bytes,3,opt,name=Order,proto3" json:"Order,omitempty"`
+	Filters              []*FilterData `protobuf:"bytes,4,rep,name=Filters" json:"Filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AccountsRequest) Reset()         { *m = AccountsRequest{} }
+func (m *AccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountsRequest) ProtoMessage()    {}
+func (*AccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{6}
+}
+func (m *AccountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountsRequest.Unmarshal(m, b)
+}
+func (m *AccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountsRequest.Marshal(b, m, deterministic)
+}
+func (dst *AccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountsRequest.Merge(dst, src)
+}
+func (m *AccountsRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountsRequest.Size(m)
+}
+func (m *AccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountsRequest proto.InternalMessageInfo
+
+func (m *AccountsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *AccountsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *AccountsRequest) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+func (m *AccountsRequest) GetFilters() []*FilterData {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (*AccountsRequest) XXX_MessageName() string {
+	return "proto3.AccountsRequest"
+}
+
 type AccountsResponse struct {
 	BlockHeight          uint64             `protobuf:"varint,1,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
 	Accounts             []*AccountResponse `protobuf:"bytes,2,rep,name=Accounts" json:"Accounts,omitempty"`
+	NextCursor           string             `protobuf:"bytes,3,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -162,7 +400,7 @@ func (m *AccountsResponse) Reset()         { *m = AccountsResponse{} }
 func (m *AccountsResponse) String() string { return proto.CompactTextString(m) }
 func (*AccountsResponse) ProtoMessage()    {}
 func (*AccountsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{3}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{7}
 }
 func (m *AccountsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountsResponse.Unmarshal(m, b)
@@ -196,6 +434,13 @@ func (m *AccountsResponse) GetAccounts() []*AccountResponse {
 	return nil
 }
 
+func (m *AccountsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
 func (*AccountsResponse) XXX_MessageName() string {
 	return "proto3.AccountsResponse"
 }
@@ -211,7 +456,7 @@ func (m *ValidatorResponse) Reset()         { *m = ValidatorResponse{} }
 func (m *ValidatorResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidatorResponse) ProtoMessage()    {}
 func (*ValidatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{4}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{8}
 }
 func (m *ValidatorResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValidatorResponse.Unmarshal(m, b)
@@ -242,9 +487,62 @@ func (*ValidatorResponse) XXX_MessageName() string {
 	return "proto3.ValidatorResponse"
 }
 
+// ValidatorsRequest lists the validators by Status: "active" (in the validator set),
+// "inactive" (bonded but not in the set) or all if the status is not set
+type ValidatorsRequest struct {
+	Page                 *PageRequest `protobuf:"bytes,1,opt,name=Page" json:"Page,omitempty"`
+	Status               string       `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ValidatorsRequest) Reset()         { *m = ValidatorsRequest{} }
+func (m *ValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorsRequest) ProtoMessage()    {}
+func (*ValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{9}
+}
+func (m *ValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorsRequest.Unmarshal(m, b)
+}
+func (m *ValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorsRequest.Merge(dst, src)
+}
+func (m *ValidatorsRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidatorsRequest.Size(m)
+}
+func (m *ValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorsRequest proto.InternalMessageInfo
+
+func (m *ValidatorsRequest) GetPage() *PageRequest {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+func (m *ValidatorsRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (*ValidatorsRequest) XXX_MessageName() string {
+	return "proto3.ValidatorsRequest"
+}
+
 type ValidatorsResponse struct {
 	BlockHeight          uint64           `protobuf:"varint,1,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
 	Validators           []*ValidatorInfo `protobuf:"bytes,2,rep,name=Validators" json:"Validators,omitempty"`
+	NextCursor           string           `protobuf:"bytes,3,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -254,7 +552,7 @@ func (m *ValidatorsResponse) Reset()         { *m = ValidatorsResponse{} }
 func (m *ValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidatorsResponse) ProtoMessage()    {}
 func (*ValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{5}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{10}
 }
 func (m *ValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValidatorsResponse.Unmarshal(m, b)
@@ -288,10 +586,175 @@ func (m *ValidatorsResponse) GetValidators() []*ValidatorInfo {
 	return nil
 }
 
+func (m *ValidatorsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
 func (*ValidatorsResponse) XXX_MessageName() string {
 	return "proto3.ValidatorsResponse"
 }
 
+type ValidatorSetResponse struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Validators           []string `protobuf:"bytes,2,rep,name=Validators" json:"Validators,omitempty"`
+	Leavers              []string `protobuf:"bytes,3,rep,name=Leavers" json:"Leavers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorSetResponse) Reset()         { *m = ValidatorSetResponse{} }
+func (m *ValidatorSetResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetResponse) ProtoMessage()    {}
+func (*ValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{11}
+}
+func (m *ValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorSetResponse.Unmarshal(m, b)
+}
+func (m *ValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorSetResponse.Marshal(b, m, deterministic)
+}
+func (dst *ValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetResponse.Merge(dst, src)
+}
+func (m *ValidatorSetResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidatorSetResponse.Size(m)
+}
+func (m *ValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetResponse proto.InternalMessageInfo
+
+func (m *ValidatorSetResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ValidatorSetResponse) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *ValidatorSetResponse) GetLeavers() []string {
+	if m != nil {
+		return m.Leavers
+	}
+	return nil
+}
+
+func (*ValidatorSetResponse) XXX_MessageName() string {
+	return "proto3.ValidatorSetResponse"
+}
+
+type ValidatorEvent struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorEvent) Reset()         { *m = ValidatorEvent{} }
+func (m *ValidatorEvent) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEvent) ProtoMessage()    {}
+func (*ValidatorEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{12}
+}
+func (m *ValidatorEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorEvent.Unmarshal(m, b)
+}
+func (m *ValidatorEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorEvent.Marshal(b, m, deterministic)
+}
+func (dst *ValidatorEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEvent.Merge(dst, src)
+}
+func (m *ValidatorEvent) XXX_Size() int {
+	return xxx_messageInfo_ValidatorEvent.Size(m)
+}
+func (m *ValidatorEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorEvent proto.InternalMessageInfo
+
+func (m *ValidatorEvent) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ValidatorEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (*ValidatorEvent) XXX_MessageName() string {
+	return "proto3.ValidatorEvent"
+}
+
+type ValidatorHistoryResponse struct {
+	Address              string            `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Events               []*ValidatorEvent `protobuf:"bytes,2,rep,name=Events" json:"Events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ValidatorHistoryResponse) Reset()         { *m = ValidatorHistoryResponse{} }
+func (m *ValidatorHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorHistoryResponse) ProtoMessage()    {}
+func (*ValidatorHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{13}
+}
+func (m *ValidatorHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorHistoryResponse.Unmarshal(m, b)
+}
+func (m *ValidatorHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorHistoryResponse.Marshal(b, m, deterministic)
+}
+func (dst *ValidatorHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorHistoryResponse.Merge(dst, src)
+}
+func (m *ValidatorHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidatorHistoryResponse.Size(m)
+}
+func (m *ValidatorHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorHistoryResponse proto.InternalMessageInfo
+
+func (m *ValidatorHistoryResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorHistoryResponse) GetEvents() []*ValidatorEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (*ValidatorHistoryResponse) XXX_MessageName() string {
+	return "proto3.ValidatorHistoryResponse"
+}
+
 type ListAccountsParam struct {
 	Query                string   `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -303,7 +766,7 @@ func (m *ListAccountsParam) Reset()         { *m = ListAccountsParam{} }
 func (m *ListAccountsParam) String() string { return proto.CompactTextString(m) }
 func (*ListAccountsParam) ProtoMessage()    {}
 func (*ListAccountsParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{6}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{14}
 }
 func (m *ListAccountsParam) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListAccountsParam.Unmarshal(m, b)
@@ -345,7 +808,7 @@ func (m *StorageRequest) Reset()         { *m = StorageRequest{} }
 func (m *StorageRequest) String() string { return proto.CompactTextString(m) }
 func (*StorageRequest) ProtoMessage()    {}
 func (*StorageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{7}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{15}
 }
 func (m *StorageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageRequest.Unmarshal(m, b)
@@ -387,7 +850,7 @@ func (m *StorageResponse) Reset()         { *m = StorageResponse{} }
 func (m *StorageResponse) String() string { return proto.CompactTextString(m) }
 func (*StorageResponse) ProtoMessage()    {}
 func (*StorageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{8}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{16}
 }
 func (m *StorageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageResponse.Unmarshal(m, b)
@@ -430,7 +893,7 @@ func (m *StorageItem) Reset()         { *m = StorageItem{} }
 func (m *StorageItem) String() string { return proto.CompactTextString(m) }
 func (*StorageItem) ProtoMessage()    {}
 func (*StorageItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{9}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{17}
 }
 func (m *StorageItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageItem.Unmarshal(m, b)
@@ -466,7 +929,7 @@ func (m *StorageAtRequest) Reset()         { *m = StorageAtRequest{} }
 func (m *StorageAtRequest) String() string { return proto.CompactTextString(m) }
 func (*StorageAtRequest) ProtoMessage()    {}
 func (*StorageAtRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{10}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{18}
 }
 func (m *StorageAtRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageAtRequest.Unmarshal(m, b)
@@ -509,7 +972,7 @@ func (m *StorageAtResponse) Reset()         { *m = StorageAtResponse{} }
 func (m *StorageAtResponse) String() string { return proto.CompactTextString(m) }
 func (*StorageAtResponse) ProtoMessage()    {}
 func (*StorageAtResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{11}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{19}
 }
 func (m *StorageAtResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageAtResponse.Unmarshal(m, b)
@@ -545,7 +1008,7 @@ func (m *ConsensusResponse) Reset()         { *m = ConsensusResponse{} }
 func (m *ConsensusResponse) String() string { return proto.CompactTextString(m) }
 func (*ConsensusResponse) ProtoMessage()    {}
 func (*ConsensusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{12}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{20}
 }
 func (m *ConsensusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConsensusResponse.Unmarshal(m, b)
@@ -582,7 +1045,7 @@ func (m *ChainResponse) Reset()         { *m = ChainResponse{} }
 func (m *ChainResponse) String() string { return proto.CompactTextString(m) }
 func (*ChainResponse) ProtoMessage()    {}
 func (*ChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{13}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{21}
 }
 func (m *ChainResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChainResponse.Unmarshal(m, b)
@@ -637,7 +1100,7 @@ func (m *StatusResponse) Reset()         { *m = StatusResponse{} }
 func (m *StatusResponse) String() string { return proto.CompactTextString(m) }
 func (*StatusResponse) ProtoMessage()    {}
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{14}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{22}
 }
 func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatusResponse.Unmarshal(m, b)
@@ -693,7 +1156,7 @@ func (m *BlockRequest) Reset()         { *m = BlockRequest{} }
 func (m *BlockRequest) String() string { return proto.CompactTextString(m) }
 func (*BlockRequest) ProtoMessage()    {}
 func (*BlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{15}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{23}
 }
 func (m *BlockRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockRequest.Unmarshal(m, b)
@@ -725,18 +1188,19 @@ func (*BlockRequest) XXX_MessageName() string {
 }
 
 type BlocksRequest struct {
-	MinHeight            uint64   `protobuf:"varint,1,opt,name=minHeight,proto3" json:"minHeight,omitempty"`
-	MaxHeight            uint64   `protobuf:"varint,2,opt,name=maxHeight,proto3" json:"maxHeight,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	MinHeight            uint64       `protobuf:"varint,1,opt,name=minHeight,proto3" json:"minHeight,omitempty"`
+	MaxHeight            uint64       `protobuf:"varint,2,opt,name=maxHeight,proto3" json:"maxHeight,omitempty"`
+	Page                 *PageRequest `protobuf:"bytes,3,opt,name=page" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *BlocksRequest) Reset()         { *m = BlocksRequest{} }
 func (m *BlocksRequest) String() string { return proto.CompactTextString(m) }
 func (*BlocksRequest) ProtoMessage()    {}
 func (*BlocksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{16}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{24}
 }
 func (m *BlocksRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlocksRequest.Unmarshal(m, b)
@@ -770,10 +1234,61 @@ func (m *BlocksRequest) GetMaxHeight() uint64 {
 	return 0
 }
 
+func (m *BlocksRequest) GetPage() *PageRequest {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
 func (*BlocksRequest) XXX_MessageName() string {
 	return "proto3.BlocksRequest"
 }
 
+// SubscribeBlocksRequest starts the subscription from FromHeight.
+// Zero FromHeight means only the new blocks are streamed.
+type SubscribeBlocksRequest struct {
+	FromHeight           uint64   `protobuf:"varint,1,opt,name=FromHeight,proto3" json:"FromHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeBlocksRequest) Reset()         { *m = SubscribeBlocksRequest{} }
+func (m *SubscribeBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeBlocksRequest) ProtoMessage()    {}
+func (*SubscribeBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{25}
+}
+func (m *SubscribeBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeBlocksRequest.Unmarshal(m, b)
+}
+func (m *SubscribeBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeBlocksRequest.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeBlocksRequest.Merge(dst, src)
+}
+func (m *SubscribeBlocksRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeBlocksRequest.Size(m)
+}
+func (m *SubscribeBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeBlocksRequest proto.InternalMessageInfo
+
+func (m *SubscribeBlocksRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (*SubscribeBlocksRequest) XXX_MessageName() string {
+	return "proto3.SubscribeBlocksRequest"
+}
+
 type BlockResponse struct {
 	Block                *BlockInfo `protobuf:"bytes,1,opt,name=Block" json:"Block,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
@@ -785,7 +1300,7 @@ func (m *BlockResponse) Reset()         { *m = BlockResponse{} }
 func (m *BlockResponse) String() string { return proto.CompactTextString(m) }
 func (*BlockResponse) ProtoMessage()    {}
 func (*BlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{17}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{26}
 }
 func (m *BlockResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockResponse.Unmarshal(m, b)
@@ -818,6 +1333,7 @@ func (*BlockResponse) XXX_MessageName() string {
 
 type BlocksResponse struct {
 	Blocks               []BlockInfo `protobuf:"bytes,1,rep,name=Blocks" json:"Blocks"`
+	NextCursor           string      `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -827,7 +1343,7 @@ func (m *BlocksResponse) Reset()         { *m = BlocksResponse{} }
 func (m *BlocksResponse) String() string { return proto.CompactTextString(m) }
 func (*BlocksResponse) ProtoMessage()    {}
 func (*BlocksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{18}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{27}
 }
 func (m *BlocksResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlocksResponse.Unmarshal(m, b)
@@ -851,7 +1367,14 @@ func (m *BlocksResponse) GetBlocks() []BlockInfo {
 	if m != nil {
 		return m.Blocks
 	}
-	return nil
+	return nil
+}
+
+func (m *BlocksResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
 }
 
 func (*BlocksResponse) XXX_MessageName() string {
@@ -869,7 +1392,7 @@ func (m *GenesisResponse) Reset()         { *m = GenesisResponse{} }
 func (m *GenesisResponse) String() string { return proto.CompactTextString(m) }
 func (*GenesisResponse) ProtoMessage()    {}
 func (*GenesisResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{19}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{28}
 }
 func (m *GenesisResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenesisResponse.Unmarshal(m, b)
@@ -905,7 +1428,7 @@ func (m *BlockTxsResponse) Reset()         { *m = BlockTxsResponse{} }
 func (m *BlockTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*BlockTxsResponse) ProtoMessage()    {}
 func (*BlockTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{20}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{29}
 }
 func (m *BlockTxsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockTxsResponse.Unmarshal(m, b)
@@ -956,7 +1479,7 @@ func (m *BlockchainInfoResponse) Reset()         { *m = BlockchainInfoResponse{}
 func (m *BlockchainInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*BlockchainInfoResponse) ProtoMessage()    {}
 func (*BlockchainInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{21}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{30}
 }
 func (m *BlockchainInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockchainInfoResponse.Unmarshal(m, b)
@@ -1005,7 +1528,7 @@ func (m *TxRequest) Reset()         { *m = TxRequest{} }
 func (m *TxRequest) String() string { return proto.CompactTextString(m) }
 func (*TxRequest) ProtoMessage()    {}
 func (*TxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{22}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{31}
 }
 func (m *TxRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxRequest.Unmarshal(m, b)
@@ -1047,7 +1570,7 @@ func (m *TxResponse) Reset()         { *m = TxResponse{} }
 func (m *TxResponse) String() string { return proto.CompactTextString(m) }
 func (*TxResponse) ProtoMessage()    {}
 func (*TxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{23}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{32}
 }
 func (m *TxResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxResponse.Unmarshal(m, b)
@@ -1078,6 +1601,206 @@ func (*TxResponse) XXX_MessageName() string {
 	return "proto3.TxResponse"
 }
 
+// AccountTxsRequest lists the transactions of the account between FromHeight and ToHeight.
+// Zero ToHeight means up to the last block. The newest transactions are listed first by default.
+type AccountTxsRequest struct {
+	Address              string       `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	FromHeight           uint64       `protobuf:"varint,2,opt,name=FromHeight,proto3" json:"FromHeight,omitempty"`
+	ToHeight             uint64       `protobuf:"varint,3,opt,name=ToHeight,proto3" json:"ToHeight,omitempty"`
+	Page                 *PageRequest `protobuf:"bytes,4,opt,name=Page" json:"Page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *AccountTxsRequest) Reset()         { *m = AccountTxsRequest{} }
+func (m *AccountTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountTxsRequest) ProtoMessage()    {}
+func (*AccountTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{33}
+}
+func (m *AccountTxsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountTxsRequest.Unmarshal(m, b)
+}
+func (m *AccountTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountTxsRequest.Marshal(b, m, deterministic)
+}
+func (dst *AccountTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTxsRequest.Merge(dst, src)
+}
+func (m *AccountTxsRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountTxsRequest.Size(m)
+}
+func (m *AccountTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTxsRequest proto.InternalMessageInfo
+
+func (m *AccountTxsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountTxsRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *AccountTxsRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *AccountTxsRequest) GetPage() *PageRequest {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+func (*AccountTxsRequest) XXX_MessageName() string {
+	return "proto3.AccountTxsRequest"
+}
+
+type AccountTx struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Index                uint32   `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
+	Hash                 string   `protobuf:"bytes,3,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Type                 string   `protobuf:"bytes,4,opt,name=Type,proto3" json:"Type,omitempty"`
+	Status               int32    `protobuf:"varint,5,opt,name=Status,proto3" json:"Status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountTx) Reset()         { *m = AccountTx{} }
+func (m *AccountTx) String() string { return proto.CompactTextString(m) }
+func (*AccountTx) ProtoMessage()    {}
+func (*AccountTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{34}
+}
+func (m *AccountTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountTx.Unmarshal(m, b)
+}
+func (m *AccountTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountTx.Marshal(b, m, deterministic)
+}
+func (dst *AccountTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTx.Merge(dst, src)
+}
+func (m *AccountTx) XXX_Size() int {
+	return xxx_messageInfo_AccountTx.Size(m)
+}
+func (m *AccountTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTx proto.InternalMessageInfo
+
+func (m *AccountTx) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AccountTx) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *AccountTx) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *AccountTx) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AccountTx) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (*AccountTx) XXX_MessageName() string {
+	return "proto3.AccountTx"
+}
+
+type AccountTxsResponse struct {
+	Address              string       `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Txs                  []*AccountTx `protobuf:"bytes,2,rep,name=Txs" json:"Txs,omitempty"`
+	NextCursor           string       `protobuf:"bytes,3,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *AccountTxsResponse) Reset()         { *m = AccountTxsResponse{} }
+func (m *AccountTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountTxsResponse) ProtoMessage()    {}
+func (*AccountTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{35}
+}
+func (m *AccountTxsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountTxsResponse.Unmarshal(m, b)
+}
+func (m *AccountTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountTxsResponse.Marshal(b, m, deterministic)
+}
+func (dst *AccountTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTxsResponse.Merge(dst, src)
+}
+func (m *AccountTxsResponse) XXX_Size() int {
+	return xxx_messageInfo_AccountTxsResponse.Size(m)
+}
+func (m *AccountTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTxsResponse proto.InternalMessageInfo
+
+func (m *AccountTxsResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountTxsResponse) GetTxs() []*AccountTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *AccountTxsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+func (*AccountTxsResponse) XXX_MessageName() string {
+	return "proto3.AccountTxsResponse"
+}
+
 type BlockInfo struct {
 	Header               HeaderInfo     `protobuf:"bytes,1,opt,name=header" json:"header"`
 	LastCommitInfo       CommitInfo     `protobuf:"bytes,2,opt,name=last_commit_info,json=lastCommitInfo" json:"last_commit_info"`
@@ -1092,7 +1815,7 @@ func (m *BlockInfo) Reset()         { *m = BlockInfo{} }
 func (m *BlockInfo) String() string { return proto.CompactTextString(m) }
 func (*BlockInfo) ProtoMessage()    {}
 func (*BlockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{24}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{36}
 }
 func (m *BlockInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockInfo.Unmarshal(m, b)
@@ -1176,7 +1899,7 @@ func (m *HeaderInfo) Reset()         { *m = HeaderInfo{} }
 func (m *HeaderInfo) String() string { return proto.CompactTextString(m) }
 func (*HeaderInfo) ProtoMessage()    {}
 func (*HeaderInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{25}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{37}
 }
 func (m *HeaderInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeaderInfo.Unmarshal(m, b)
@@ -1268,7 +1991,7 @@ func (m *Version) Reset()         { *m = Version{} }
 func (m *Version) String() string { return proto.CompactTextString(m) }
 func (*Version) ProtoMessage()    {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{26}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{38}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Version.Unmarshal(m, b)
@@ -1318,7 +2041,7 @@ func (m *CommitInfo) Reset()         { *m = CommitInfo{} }
 func (m *CommitInfo) String() string { return proto.CompactTextString(m) }
 func (*CommitInfo) ProtoMessage()    {}
 func (*CommitInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{27}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{39}
 }
 func (m *CommitInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommitInfo.Unmarshal(m, b)
@@ -1364,7 +2087,7 @@ func (m *VoteInfo) Reset()         { *m = VoteInfo{} }
 func (m *VoteInfo) String() string { return proto.CompactTextString(m) }
 func (*VoteInfo) ProtoMessage()    {}
 func (*VoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{28}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{40}
 }
 func (m *VoteInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VoteInfo.Unmarshal(m, b)
@@ -1428,6 +2151,8 @@ type ValidatorInfo struct {
 	PubKey               string   `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
 	Power                int64    `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
 	Stake                uint64   `protobuf:"varint,4,opt,name=stake,proto3" json:"stake,omitempty"`
+	BondingHeight        uint64   `protobuf:"varint,5,opt,name=bonding_height,json=bondingHeight,proto3" json:"bonding_height,omitempty"`
+	Sequence             uint64   `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1437,7 +2162,7 @@ func (m *ValidatorInfo) Reset()         { *m = ValidatorInfo{} }
 func (m *ValidatorInfo) String() string { return proto.CompactTextString(m) }
 func (*ValidatorInfo) ProtoMessage()    {}
 func (*ValidatorInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{29}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{41}
 }
 func (m *ValidatorInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValidatorInfo.Unmarshal(m, b)
@@ -1485,6 +2210,20 @@ func (m *ValidatorInfo) GetStake() uint64 {
 	return 0
 }
 
+func (m *ValidatorInfo) GetBondingHeight() uint64 {
+	if m != nil {
+		return m.BondingHeight
+	}
+	return 0
+}
+
+func (m *ValidatorInfo) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 func (*ValidatorInfo) XXX_MessageName() string {
 	return "proto3.ValidatorInfo"
 }
@@ -1501,7 +2240,7 @@ func (m *EvidenceInfo) Reset()         { *m = EvidenceInfo{} }
 func (m *EvidenceInfo) String() string { return proto.CompactTextString(m) }
 func (*EvidenceInfo) ProtoMessage()    {}
 func (*EvidenceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{30}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{42}
 }
 func (m *EvidenceInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EvidenceInfo.Unmarshal(m, b)
@@ -1545,6 +2284,7 @@ type TxInfo struct {
 	GasUsed              int64    `protobuf:"varint,3,opt,name=GasUsed,proto3" json:"GasUsed,omitempty"`
 	GasWanted            int64    `protobuf:"varint,4,opt,name=GasWanted,proto3" json:"GasWanted,omitempty"`
 	Envelope             string   `protobuf:"bytes,5,opt,name=Envelope,proto3" json:"Envelope,omitempty"`
+	Status               int32    `protobuf:"varint,6,opt,name=Status,proto3" json:"Status,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1554,7 +2294,7 @@ func (m *TxInfo) Reset()         { *m = TxInfo{} }
 func (m *TxInfo) String() string { return proto.CompactTextString(m) }
 func (*TxInfo) ProtoMessage()    {}
 func (*TxInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c18a6264c2095c1e, []int{31}
+	return fileDescriptor_blockchain_4c448eeaa4e1a0c1, []int{43}
 }
 func (m *TxInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxInfo.Unmarshal(m, b)
@@ -1609,6 +2349,13 @@ func (m *TxInfo) GetEnvelope() string {
 	return ""
 }
 
+func (m *TxInfo) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
 func (*TxInfo) XXX_MessageName() string {
 	return "proto3.TxInfo"
 }
@@ -1619,12 +2366,28 @@ func init() {
 	golang_proto.RegisterType((*AddressRequest)(nil), "proto3.AddressRequest")
 	proto.RegisterType((*AccountResponse)(nil), "proto3.AccountResponse")
 	golang_proto.RegisterType((*AccountResponse)(nil), "proto3.AccountResponse")
+	proto.RegisterType((*PageRequest)(nil), "proto3.PageRequest")
+	golang_proto.RegisterType((*PageRequest)(nil), "proto3.PageRequest")
+	proto.RegisterType((*FilterData)(nil), "proto3.FilterData")
+	golang_proto.RegisterType((*FilterData)(nil), "proto3.FilterData")
+	proto.RegisterType((*FilterGroup)(nil), "proto3.FilterGroup")
+	golang_proto.RegisterType((*FilterGroup)(nil), "proto3.FilterGroup")
+	proto.RegisterType((*AccountsRequest)(nil), "proto3.AccountsRequest")
+	golang_proto.RegisterType((*AccountsRequest)(nil), "proto3.AccountsRequest")
 	proto.RegisterType((*AccountsResponse)(nil), "proto3.AccountsResponse")
 	golang_proto.RegisterType((*AccountsResponse)(nil), "proto3.AccountsResponse")
 	proto.RegisterType((*ValidatorResponse)(nil), "proto3.ValidatorResponse")
 	golang_proto.RegisterType((*ValidatorResponse)(nil), "proto3.ValidatorResponse")
+	proto.RegisterType((*ValidatorsRequest)(nil), "proto3.ValidatorsRequest")
+	golang_proto.RegisterType((*ValidatorsRequest)(nil), "proto3.ValidatorsRequest")
 	proto.RegisterType((*ValidatorsResponse)(nil), "proto3.ValidatorsResponse")
 	golang_proto.RegisterType((*ValidatorsResponse)(nil), "proto3.ValidatorsResponse")
+	proto.RegisterType((*ValidatorSetResponse)(nil), "proto3.ValidatorSetResponse")
+	golang_proto.RegisterType((*ValidatorSetResponse)(nil), "proto3.ValidatorSetResponse")
+	proto.RegisterType((*ValidatorEvent)(nil), "proto3.ValidatorEvent")
+	golang_proto.RegisterType((*ValidatorEvent)(nil), "proto3.ValidatorEvent")
+	proto.RegisterType((*ValidatorHistoryResponse)(nil), "proto3.ValidatorHistoryResponse")
+	golang_proto.RegisterType((*ValidatorHistoryResponse)(nil), "proto3.ValidatorHistoryResponse")
 	proto.RegisterType((*ListAccountsParam)(nil), "proto3.ListAccountsParam")
 	golang_proto.RegisterType((*ListAccountsParam)(nil), "proto3.ListAccountsParam")
 	proto.RegisterType((*StorageRequest)(nil), "proto3.StorageRequest")
@@ -1647,6 +2410,8 @@ func init() {
 	golang_proto.RegisterType((*BlockRequest)(nil), "proto3.BlockRequest")
 	proto.RegisterType((*BlocksRequest)(nil), "proto3.BlocksRequest")
 	golang_proto.RegisterType((*BlocksRequest)(nil), "proto3.BlocksRequest")
+	proto.RegisterType((*SubscribeBlocksRequest)(nil), "proto3.SubscribeBlocksRequest")
+	golang_proto.RegisterType((*SubscribeBlocksRequest)(nil), "proto3.SubscribeBlocksRequest")
 	proto.RegisterType((*BlockResponse)(nil), "proto3.BlockResponse")
 	golang_proto.RegisterType((*BlockResponse)(nil), "proto3.BlockResponse")
 	proto.RegisterType((*BlocksResponse)(nil), "proto3.BlocksResponse")
@@ -1661,6 +2426,12 @@ func init() {
 	golang_proto.RegisterType((*TxRequest)(nil), "proto3.TxRequest")
 	proto.RegisterType((*TxResponse)(nil), "proto3.TxResponse")
 	golang_proto.RegisterType((*TxResponse)(nil), "proto3.TxResponse")
+	proto.RegisterType((*AccountTxsRequest)(nil), "proto3.AccountTxsRequest")
+	golang_proto.RegisterType((*AccountTxsRequest)(nil), "proto3.AccountTxsRequest")
+	proto.RegisterType((*AccountTx)(nil), "proto3.AccountTx")
+	golang_proto.RegisterType((*AccountTx)(nil), "proto3.AccountTx")
+	proto.RegisterType((*AccountTxsResponse)(nil), "proto3.AccountTxsResponse")
+	golang_proto.RegisterType((*AccountTxsResponse)(nil), "proto3.AccountTxsResponse")
 	proto.RegisterType((*BlockInfo)(nil), "proto3.BlockInfo")
 	golang_proto.RegisterType((*BlockInfo)(nil), "proto3.BlockInfo")
 	proto.RegisterType((*HeaderInfo)(nil), "proto3.HeaderInfo")
@@ -1692,11 +2463,16 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type BlockChainClient interface {
 	GetAccount(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*AccountResponse, error)
-	GetAccounts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AccountsResponse, error)
+	// GetMempoolAccount returns the account with the changes of the transactions in the mempool, like the pending sequence
+	GetMempoolAccount(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*AccountResponse, error)
+	// GetAccounts lists a page of the accounts. The filters can't be sent in a query string, so they are posted.
+	GetAccounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
 	GetStorage(ctx context.Context, in *StorageRequest, opts ...grpc.CallOption) (*StorageResponse, error)
 	GetStorageAt(ctx context.Context, in *StorageAtRequest, opts ...grpc.CallOption) (*StorageAtResponse, error)
 	GetValidator(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*ValidatorResponse, error)
-	GetValidators(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ValidatorsResponse, error)
+	GetValidators(ctx context.Context, in *ValidatorsRequest, opts ...grpc.CallOption) (*ValidatorsResponse, error)
+	GetValidatorSet(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*ValidatorSetResponse, error)
+	GetValidatorHistory(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*ValidatorHistoryResponse, error)
 	GetStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StatusResponse, error)
 	GetGenesis(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GenesisResponse, error)
 	GetChainID(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChainResponse, error)
@@ -1706,7 +2482,12 @@ type BlockChainClient interface {
 	GetBlocks(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (*BlocksResponse, error)
 	GetBlockchainInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BlockchainInfoResponse, error)
 	GetTx(ctx context.Context, in *TxRequest, opts ...grpc.CallOption) (*TxResponse, error)
+	GetAccountTxs(ctx context.Context, in *AccountTxsRequest, opts ...grpc.CallOption) (*AccountTxsResponse, error)
 	GetBlockTxs(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockTxsResponse, error)
+	// Streams the committed blocks. The blocks from FromHeight are replayed first.
+	SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (BlockChain_SubscribeBlocksClient, error)
+	// Streams the headers of the committed blocks. The headers from FromHeight are replayed first.
+	SubscribeHeaders(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (BlockChain_SubscribeHeadersClient, error)
 }
 
 type blockChainClient struct {
@@ -1726,7 +2507,16 @@ func (c *blockChainClient) GetAccount(ctx context.Context, in *AddressRequest, o
 	return out, nil
 }
 
-func (c *blockChainClient) GetAccounts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AccountsResponse, error) {
+func (c *blockChainClient) GetMempoolAccount(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*AccountResponse, error) {
+	out := new(AccountResponse)
+	err := c.cc.Invoke(ctx, "/proto3.BlockChain/GetMempoolAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockChainClient) GetAccounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error) {
 	out := new(AccountsResponse)
 	err := c.cc.Invoke(ctx, "/proto3.BlockChain/GetAccounts", in, out, opts...)
 	if err != nil {
@@ -1762,7 +2552,7 @@ func (c *blockChainClient) GetValidator(ctx context.Context, in *AddressRequest,
 	return out, nil
 }
 
-func (c *blockChainClient) GetValidators(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ValidatorsResponse, error) {
+func (c *blockChainClient) GetValidators(ctx context.Context, in *ValidatorsRequest, opts ...grpc.CallOption) (*ValidatorsResponse, error) {
 	out := new(ValidatorsResponse)
 	err := c.cc.Invoke(ctx, "/proto3.BlockChain/GetValidators", in, out, opts...)
 	if err != nil {
@@ -1771,6 +2561,24 @@ func (c *blockChainClient) GetValidators(ctx context.Context, in *Empty, opts ..
 	return out, nil
 }
 
+func (c *blockChainClient) GetValidatorSet(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*ValidatorSetResponse, error) {
+	out := new(ValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/proto3.BlockChain/GetValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockChainClient) GetValidatorHistory(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*ValidatorHistoryResponse, error) {
+	out := new(ValidatorHistoryResponse)
+	err := c.cc.Invoke(ctx, "/proto3.BlockChain/GetValidatorHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockChainClient) GetStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StatusResponse, error) {
 	out := new(StatusResponse)
 	err := c.cc.Invoke(ctx, "/proto3.BlockChain/GetStatus", in, out, opts...)
@@ -1852,6 +2660,15 @@ func (c *blockChainClient) GetTx(ctx context.Context, in *TxRequest, opts ...grp
 	return out, nil
 }
 
+func (c *blockChainClient) GetAccountTxs(ctx context.Context, in *AccountTxsRequest, opts ...grpc.CallOption) (*AccountTxsResponse, error) {
+	out := new(AccountTxsResponse)
+	err := c.cc.Invoke(ctx, "/proto3.BlockChain/GetAccountTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockChainClient) GetBlockTxs(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockTxsResponse, error) {
 	out := new(BlockTxsResponse)
 	err := c.cc.Invoke(ctx, "/proto3.BlockChain/GetBlockTxs", in, out, opts...)
@@ -1861,14 +2678,83 @@ func (c *blockChainClient) GetBlockTxs(ctx context.Context, in *BlockRequest, op
 	return out, nil
 }
 
+func (c *blockChainClient) SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (BlockChain_SubscribeBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlockChain_serviceDesc.Streams[0], "/proto3.BlockChain/SubscribeBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blockChainSubscribeBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlockChain_SubscribeBlocksClient interface {
+	Recv() (*BlockInfo, error)
+	grpc.ClientStream
+}
+
+type blockChainSubscribeBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *blockChainSubscribeBlocksClient) Recv() (*BlockInfo, error) {
+	m := new(BlockInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blockChainClient) SubscribeHeaders(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (BlockChain_SubscribeHeadersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlockChain_serviceDesc.Streams[1], "/proto3.BlockChain/SubscribeHeaders", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blockChainSubscribeHeadersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlockChain_SubscribeHeadersClient interface {
+	Recv() (*HeaderInfo, error)
+	grpc.ClientStream
+}
+
+type blockChainSubscribeHeadersClient struct {
+	grpc.ClientStream
+}
+
+func (x *blockChainSubscribeHeadersClient) Recv() (*HeaderInfo, error) {
+	m := new(HeaderInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // BlockChainServer is the server API for BlockChain service.
 type BlockChainServer interface {
 	GetAccount(context.Context, *AddressRequest) (*AccountResponse, error)
-	GetAccounts(context.Context, *Empty) (*AccountsResponse, error)
+	// GetMempoolAccount returns the account with the changes of the transactions in the mempool, like the pending sequence
+	GetMempoolAccount(context.Context, *AddressRequest) (*AccountResponse, error)
+	// GetAccounts lists a page of the accounts. The filters can't be sent in a query string, so they are posted.
+	GetAccounts(context.Context, *AccountsRequest) (*AccountsResponse, error)
 	GetStorage(context.Context, *StorageRequest) (*StorageResponse, error)
 	GetStorageAt(context.Context, *StorageAtRequest) (*StorageAtResponse, error)
 	GetValidator(context.Context, *AddressRequest) (*ValidatorResponse, error)
-	GetValidators(context.Context, *Empty) (*ValidatorsResponse, error)
+	GetValidators(context.Context, *ValidatorsRequest) (*ValidatorsResponse, error)
+	GetValidatorSet(context.Context, *BlockRequest) (*ValidatorSetResponse, error)
+	GetValidatorHistory(context.Context, *AddressRequest) (*ValidatorHistoryResponse, error)
 	GetStatus(context.Context, *Empty) (*StatusResponse, error)
 	GetGenesis(context.Context, *Empty) (*GenesisResponse, error)
 	GetChainID(context.Context, *Empty) (*ChainResponse, error)
@@ -1878,7 +2764,12 @@ type BlockChainServer interface {
 	GetBlocks(context.Context, *BlocksRequest) (*BlocksResponse, error)
 	GetBlockchainInfo(context.Context, *Empty) (*BlockchainInfoResponse, error)
 	GetTx(context.Context, *TxRequest) (*TxResponse, error)
+	GetAccountTxs(context.Context, *AccountTxsRequest) (*AccountTxsResponse, error)
 	GetBlockTxs(context.Context, *BlockRequest) (*BlockTxsResponse, error)
+	// Streams the committed blocks. The blocks from FromHeight are replayed first.
+	SubscribeBlocks(*SubscribeBlocksRequest, BlockChain_SubscribeBlocksServer) error
+	// Streams the headers of the committed blocks. The headers from FromHeight are replayed first.
+	SubscribeHeaders(*SubscribeBlocksRequest, BlockChain_SubscribeHeadersServer) error
 }
 
 func RegisterBlockChainServer(s *grpc.Server, srv BlockChainServer) {
@@ -1903,8 +2794,26 @@ func _BlockChain_GetAccount_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockChain_GetMempoolAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockChainServer).GetMempoolAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto3.BlockChain/GetMempoolAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockChainServer).GetMempoolAccount(ctx, req.(*AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlockChain_GetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(AccountsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1916,7 +2825,7 @@ func _BlockChain_GetAccounts_Handler(srv interface{}, ctx context.Context, dec f
 		FullMethod: "/proto3.BlockChain/GetAccounts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlockChainServer).GetAccounts(ctx, req.(*Empty))
+		return srv.(BlockChainServer).GetAccounts(ctx, req.(*AccountsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1976,7 +2885,7 @@ func _BlockChain_GetValidator_Handler(srv interface{}, ctx context.Context, dec
 }
 
 func _BlockChain_GetValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(ValidatorsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1988,7 +2897,43 @@ func _BlockChain_GetValidators_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: "/proto3.BlockChain/GetValidators",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlockChainServer).GetValidators(ctx, req.(*Empty))
+		return srv.(BlockChainServer).GetValidators(ctx, req.(*ValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockChain_GetValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockChainServer).GetValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto3.BlockChain/GetValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockChainServer).GetValidatorSet(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockChain_GetValidatorHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockChainServer).GetValidatorHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto3.BlockChain/GetValidatorHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockChainServer).GetValidatorHistory(ctx, req.(*AddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2155,6 +3100,24 @@ func _BlockChain_GetTx_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockChain_GetAccountTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockChainServer).GetAccountTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto3.BlockChain/GetAccountTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockChainServer).GetAccountTxs(ctx, req.(*AccountTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlockChain_GetBlockTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlockRequest)
 	if err := dec(in); err != nil {
@@ -2173,6 +3136,48 @@ func _BlockChain_GetBlockTxs_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockChain_SubscribeBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlockChainServer).SubscribeBlocks(m, &blockChainSubscribeBlocksServer{stream})
+}
+
+type BlockChain_SubscribeBlocksServer interface {
+	Send(*BlockInfo) error
+	grpc.ServerStream
+}
+
+type blockChainSubscribeBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *blockChainSubscribeBlocksServer) Send(m *BlockInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlockChain_SubscribeHeaders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlockChainServer).SubscribeHeaders(m, &blockChainSubscribeHeadersServer{stream})
+}
+
+type BlockChain_SubscribeHeadersServer interface {
+	Send(*HeaderInfo) error
+	grpc.ServerStream
+}
+
+type blockChainSubscribeHeadersServer struct {
+	grpc.ServerStream
+}
+
+func (x *blockChainSubscribeHeadersServer) Send(m *HeaderInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _BlockChain_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "proto3.BlockChain",
 	HandlerType: (*BlockChainServer)(nil),
@@ -2181,6 +3186,10 @@ var _BlockChain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAccount",
 			Handler:    _BlockChain_GetAccount_Handler,
 		},
+		{
+			MethodName: "GetMempoolAccount",
+			Handler:    _BlockChain_GetMempoolAccount_Handler,
+		},
 		{
 			MethodName: "GetAccounts",
 			Handler:    _BlockChain_GetAccounts_Handler,
@@ -2201,6 +3210,14 @@ var _BlockChain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetValidators",
 			Handler:    _BlockChain_GetValidators_Handler,
 		},
+		{
+			MethodName: "GetValidatorSet",
+			Handler:    _BlockChain_GetValidatorSet_Handler,
+		},
+		{
+			MethodName: "GetValidatorHistory",
+			Handler:    _BlockChain_GetValidatorHistory_Handler,
+		},
 		{
 			MethodName: "GetStatus",
 			Handler:    _BlockChain_GetStatus_Handler,
@@ -2237,12 +3254,27 @@ var _BlockChain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTx",
 			Handler:    _BlockChain_GetTx_Handler,
 		},
+		{
+			MethodName: "GetAccountTxs",
+			Handler:    _BlockChain_GetAccountTxs_Handler,
+		},
 		{
 			MethodName: "GetBlockTxs",
 			Handler:    _BlockChain_GetBlockTxs_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeBlocks",
+			Handler:       _BlockChain_SubscribeBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeHeaders",
+			Handler:       _BlockChain_SubscribeHeaders_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "rpc/grpc/proto3/blockchain.proto",
 }
 
@@ -2290,6 +3322,106 @@ func (m *AccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *PageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovBlockchain(uint64(m.Limit))
+	}
+	l = len(m.Order)
+	if l > 0 {
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FilterData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	l = len(m.Op)
+	if l > 0 {
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	if len(m.Or) > 0 {
+		for _, e := range m.Or {
+			l = e.Size()
+			n += 1 + l + sovBlockchain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FilterGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovBlockchain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovBlockchain(uint64(m.Limit))
+	}
+	l = len(m.Order)
+	if l > 0 {
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovBlockchain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AccountsResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2305,6 +3437,10 @@ func (m *AccountsResponse) Size() (n int) {
 			n += 1 + l + sovBlockchain(uint64(l))
 		}
 	}
+	l = len(m.NextCursor)
+	if l > 0 {
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2327,6 +3463,26 @@ func (m *ValidatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != nil {
+		l = m.Page.Size()
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ValidatorsResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2342,6 +3498,78 @@ func (m *ValidatorsResponse) Size() (n int) {
 			n += 1 + l + sovBlockchain(uint64(l))
 		}
 	}
+	l = len(m.NextCursor)
+	if l > 0 {
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBlockchain(uint64(m.Height))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovBlockchain(uint64(l))
+		}
+	}
+	if len(m.Leavers) > 0 {
+		for _, s := range m.Leavers {
+			l = len(s)
+			n += 1 + l + sovBlockchain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBlockchain(uint64(m.Height))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovBlockchain(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2547,6 +3775,25 @@ func (m *BlocksRequest) Size() (n int) {
 	if m.MaxHeight != 0 {
 		n += 1 + sovBlockchain(uint64(m.MaxHeight))
 	}
+	if m.Page != nil {
+		l = m.Page.Size()
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubscribeBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovBlockchain(uint64(m.FromHeight))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2581,6 +3828,10 @@ func (m *BlocksResponse) Size() (n int) {
 			n += 1 + l + sovBlockchain(uint64(l))
 		}
 	}
+	l = len(m.NextCursor)
+	if l > 0 {
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2675,6 +3926,87 @@ func (m *TxResponse) Size() (n int) {
 	return n
 }
 
+func (m *AccountTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovBlockchain(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovBlockchain(uint64(m.ToHeight))
+	}
+	if m.Page != nil {
+		l = m.Page.Size()
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AccountTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBlockchain(uint64(m.Height))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBlockchain(uint64(m.Index))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBlockchain(uint64(m.Status))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AccountTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovBlockchain(uint64(l))
+		}
+	}
+	l = len(m.NextCursor)
+	if l > 0 {
+		n += 1 + l + sovBlockchain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *BlockInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2844,6 +4176,12 @@ func (m *ValidatorInfo) Size() (n int) {
 	if m.Stake != 0 {
 		n += 1 + sovBlockchain(uint64(m.Stake))
 	}
+	if m.BondingHeight != 0 {
+		n += 1 + sovBlockchain(uint64(m.BondingHeight))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovBlockchain(uint64(m.Sequence))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2892,6 +4230,9 @@ func (m *TxInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBlockchain(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 1 + sovBlockchain(uint64(m.Status))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2913,140 +4254,176 @@ func sozBlockchain(x uint64) (n int) {
 }
 
 func init() {
-	proto.RegisterFile("rpc/grpc/proto3/blockchain.proto", fileDescriptor_blockchain_c18a6264c2095c1e)
+	proto.RegisterFile("rpc/grpc/proto3/blockchain.proto", fileDescriptor_blockchain_4c448eeaa4e1a0c1)
 }
 func init() {
-	golang_proto.RegisterFile("rpc/grpc/proto3/blockchain.proto", fileDescriptor_blockchain_c18a6264c2095c1e)
-}
-
-var fileDescriptor_blockchain_c18a6264c2095c1e = []byte{
-	// 2044 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x73, 0x1b, 0x49,
-	0x15, 0xcf, 0xc8, 0x92, 0xa5, 0x79, 0xb6, 0x2c, 0xa9, 0x23, 0x12, 0x45, 0xb8, 0x2c, 0x33, 0x87,
-	0x6c, 0x16, 0x82, 0x06, 0x62, 0xb6, 0x36, 0x97, 0x2d, 0xb0, 0xbc, 0x59, 0xd9, 0x24, 0x24, 0x66,
-	0x22, 0x0c, 0x6c, 0x51, 0xa8, 0x46, 0x52, 0x47, 0x9e, 0x8d, 0x34, 0x33, 0xcc, 0xb4, 0x8c, 0x84,
-	0xcb, 0x1c, 0x38, 0x71, 0x80, 0x2a, 0x28, 0x2e, 0x1c, 0x39, 0x72, 0xe7, 0xc4, 0x81, 0x2a, 0x6e,
-	0xe4, 0x48, 0x15, 0xb7, 0x1c, 0x0c, 0x95, 0xec, 0x7f, 0xc0, 0x85, 0x23, 0xd5, 0x9f, 0xd3, 0x33,
-	0xc6, 0xeb, 0x6c, 0xad, 0x38, 0xec, 0xc5, 0xa5, 0x7e, 0xaf, 0xdf, 0xef, 0xbd, 0x7e, 0xfd, 0xe6,
-	0x7d, 0xb4, 0x61, 0x3b, 0x0a, 0x87, 0xf6, 0x98, 0xfe, 0x09, 0xa3, 0x80, 0x04, 0x3b, 0xf6, 0x60,
-	0x12, 0x0c, 0x9f, 0x0f, 0x8f, 0x5d, 0xcf, 0x6f, 0x33, 0x0a, 0x5a, 0xe5, 0x8c, 0xe6, 0x57, 0xc7,
-	0x1e, 0x39, 0x9e, 0x0d, 0xda, 0xc3, 0x60, 0x6a, 0x8f, 0x83, 0x71, 0xc0, 0x05, 0x06, 0xb3, 0x67,
-	0x6c, 0xc5, 0x16, 0xec, 0x17, 0x17, 0x6b, 0x6e, 0x8e, 0x83, 0x60, 0x3c, 0xc1, 0xb6, 0x1b, 0x7a,
-	0xb6, 0xeb, 0xfb, 0x01, 0x71, 0x89, 0x17, 0xf8, 0xb1, 0xe0, 0xb6, 0x04, 0x57, 0x61, 0x10, 0x6f,
-	0x8a, 0x63, 0xe2, 0x4e, 0x43, 0xbe, 0xc1, 0x2a, 0x42, 0xe1, 0xc1, 0x34, 0x24, 0x0b, 0xeb, 0xcb,
-	0xb0, 0xb1, 0x3b, 0x1a, 0x45, 0x38, 0x8e, 0x1d, 0xfc, 0x93, 0x19, 0x8e, 0x09, 0x6a, 0x40, 0x51,
-	0x50, 0x1a, 0xc6, 0xb6, 0x71, 0xc7, 0x74, 0xe4, 0xd2, 0x3a, 0x83, 0xca, 0xee, 0x70, 0x18, 0xcc,
-	0x7c, 0xe2, 0xe0, 0x38, 0x0c, 0xfc, 0x18, 0xa3, 0x8f, 0xa0, 0x28, 0x48, 0x6c, 0xf3, 0xda, 0xbd,
-	0x9b, 0x5c, 0xc1, 0x4e, 0x3b, 0xb3, 0xb3, 0xf3, 0xee, 0xcb, 0xf3, 0xd6, 0x8e, 0x7e, 0x46, 0x77,
-	0x32, 0x71, 0x87, 0xc4, 0x1b, 0x6a, 0xbf, 0x86, 0x41, 0x84, 0x6d, 0x97, 0x0b, 0x2a, 0x00, 0xa9,
-	0xc0, 0xf2, 0xa0, 0x2a, 0x7e, 0xc6, 0x4a, 0xff, 0x36, 0xac, 0x75, 0xa8, 0x47, 0xf7, 0xb1, 0x37,
-	0x3e, 0xe6, 0x36, 0xe4, 0x1d, 0x9d, 0x84, 0x76, 0xa0, 0x24, 0xa5, 0x1a, 0xb9, 0xed, 0x95, 0x4f,
-	0x30, 0xd1, 0x51, 0x1b, 0xad, 0x7d, 0xa8, 0x1d, 0xb9, 0x13, 0x6f, 0xe4, 0x92, 0x20, 0x52, 0xba,
-	0x76, 0xc0, 0x54, 0x44, 0x71, 0xda, 0x2f, 0x48, 0x28, 0xc5, 0x38, 0xf0, 0x9f, 0x05, 0x4e, 0xb2,
-	0xcf, 0x9a, 0x02, 0x52, 0x8b, 0x4f, 0x63, 0xf6, 0x3b, 0x00, 0x89, 0x9c, 0x30, 0xfc, 0x12, 0x6d,
-	0xda, 0x46, 0xeb, 0x6d, 0xa8, 0x3d, 0xf2, 0x62, 0x22, 0x0f, 0x72, 0xe8, 0x46, 0xee, 0x14, 0xd5,
-	0xa1, 0xf0, 0xdd, 0x19, 0x8e, 0x16, 0xe2, 0x3e, 0xf9, 0x82, 0xde, 0xfc, 0x53, 0x12, 0x44, 0xee,
-	0x18, 0x5f, 0x7d, 0xf3, 0x87, 0x50, 0x51, 0x7b, 0xc5, 0x11, 0xde, 0x83, 0x75, 0x41, 0x3a, 0x20,
-	0x78, 0x4a, 0x25, 0xa8, 0x89, 0xd7, 0xa5, 0x89, 0x1a, 0xaf, 0x93, 0x7f, 0x71, 0xde, 0xba, 0xe6,
-	0xa4, 0xb6, 0x5b, 0x7f, 0x32, 0x60, 0x4d, 0x23, 0xa0, 0x27, 0xb0, 0xf2, 0x10, 0x73, 0x0b, 0xd7,
-	0x3b, 0xef, 0x51, 0x81, 0x97, 0xe7, 0xad, 0x77, 0xae, 0x8c, 0x97, 0xe9, 0x34, 0xf0, 0xed, 0x81,
-	0xe7, 0xbb, 0xd1, 0xa2, 0xbd, 0x8f, 0xe7, 0x9d, 0x05, 0xc1, 0xb1, 0x43, 0x91, 0xd0, 0x53, 0x28,
-	0x1c, 0xb9, 0x93, 0x19, 0x6e, 0xe4, 0x96, 0x01, 0xc9, 0xb1, 0xac, 0x33, 0xa8, 0x0a, 0xa3, 0x77,
-	0xc9, 0x95, 0x5e, 0x93, 0x67, 0xca, 0x2d, 0xeb, 0x4c, 0xd6, 0x9f, 0x0d, 0xa8, 0x69, 0xfa, 0xc5,
-	0x4d, 0x7c, 0x3e, 0x5c, 0xf7, 0xeb, 0x1c, 0xd4, 0xf6, 0xa8, 0xbd, 0x7e, 0x3c, 0x4b, 0x3e, 0x04,
-	0x0f, 0xc0, 0x09, 0x66, 0xfe, 0xe8, 0x29, 0x71, 0x09, 0x16, 0x47, 0x38, 0x10, 0xfa, 0x76, 0x35,
-	0x7d, 0x04, 0xfb, 0x23, 0x1c, 0x4d, 0x3d, 0x9f, 0xe8, 0x3f, 0x87, 0x12, 0xcf, 0x26, 0x8b, 0x10,
-	0xc7, 0xed, 0x04, 0xea, 0xa9, 0x37, 0x0d, 0x27, 0xd8, 0xd1, 0xc0, 0xd1, 0xaf, 0x0c, 0xa8, 0x1c,
-	0x62, 0x1c, 0x25, 0x24, 0xf9, 0x5d, 0xdd, 0x92, 0x41, 0x7b, 0xc1, 0xbe, 0x4e, 0x57, 0xd8, 0xf2,
-	0xcd, 0x4f, 0x6d, 0x4b, 0x5a, 0x95, 0x93, 0x55, 0x6d, 0xfd, 0xd1, 0x80, 0xf2, 0x1e, 0xad, 0x03,
-	0xca, 0x17, 0x9b, 0x60, 0x32, 0xc2, 0x63, 0x77, 0x8a, 0x45, 0x28, 0x25, 0x04, 0x1a, 0x66, 0x6c,
-	0x71, 0x30, 0x62, 0xd7, 0x62, 0x3a, 0x72, 0x89, 0xfa, 0xb0, 0xd6, 0xc5, 0x3e, 0x8e, 0xbd, 0x78,
-	0xdf, 0x8d, 0x8f, 0x1b, 0x2b, 0xcb, 0xb8, 0x34, 0x1d, 0xd1, 0xfa, 0x6d, 0x9e, 0xa6, 0x0a, 0x97,
-	0x68, 0xf7, 0xf6, 0x11, 0x94, 0x1e, 0x07, 0x23, 0x4c, 0xf3, 0x8f, 0xb8, 0xb5, 0xc7, 0x42, 0xe1,
-	0x07, 0x6f, 0x92, 0xe3, 0x35, 0x67, 0x25, 0x1e, 0x0c, 0xef, 0x85, 0xed, 0xae, 0x44, 0x75, 0x14,
-	0x7e, 0xf6, 0x7c, 0xb9, 0x65, 0x9f, 0x0f, 0x3d, 0x81, 0xd5, 0xc3, 0xd9, 0x80, 0x7e, 0x43, 0xdc,
-	0x77, 0xef, 0x0a, 0x6c, 0xfb, 0x2a, 0xec, 0x68, 0x11, 0x92, 0xa0, 0x7d, 0x38, 0x1b, 0x4c, 0xbc,
-	0xe1, 0x43, 0xbc, 0x70, 0x04, 0x0c, 0x1a, 0x43, 0xe5, 0x11, 0xbd, 0x64, 0xc2, 0x33, 0x3a, 0xb5,
-	0x3a, 0xbf, 0x0c, 0xab, 0xb3, 0xa8, 0xe8, 0x2e, 0xd4, 0x74, 0x12, 0xaf, 0x26, 0x05, 0x56, 0x4d,
-	0x2e, 0x32, 0xd0, 0x9d, 0x94, 0x59, 0x3d, 0x6f, 0x8a, 0x1b, 0xab, 0xdb, 0xc6, 0x9d, 0x15, 0x27,
-	0x4b, 0xa6, 0xf5, 0x89, 0xba, 0xff, 0x08, 0x47, 0xb1, 0x17, 0xf8, 0x8d, 0x22, 0x0b, 0x38, 0x9d,
-	0x64, 0xdd, 0x86, 0x75, 0xb6, 0x5d, 0x66, 0xc1, 0x1b, 0xb0, 0x7a, 0xac, 0x17, 0x33, 0xb1, 0xb2,
-	0x1e, 0x42, 0x99, 0xed, 0x53, 0xed, 0xc5, 0x26, 0x98, 0x53, 0xcf, 0x4f, 0x15, 0xbe, 0x84, 0xc0,
-	0xb8, 0xee, 0x5c, 0x70, 0x73, 0x82, 0x2b, 0x09, 0xd6, 0x7d, 0x01, 0xa6, 0xc2, 0xf0, 0x2d, 0x28,
-	0x30, 0x82, 0x28, 0xc7, 0x35, 0xf9, 0x21, 0x33, 0x22, 0x0b, 0x23, 0xce, 0xb7, 0x76, 0x61, 0x43,
-	0x9a, 0x21, 0x44, 0x6d, 0x58, 0xe5, 0x14, 0x51, 0xb9, 0x2e, 0xca, 0x8a, 0xba, 0x25, 0xb6, 0x59,
-	0x3f, 0x87, 0x8a, 0x08, 0x1a, 0x85, 0xf1, 0x1c, 0x8a, 0x82, 0x94, 0xed, 0x7e, 0x32, 0x3b, 0x3b,
-	0xf7, 0x5f, 0x9e, 0xb7, 0xbe, 0xf1, 0x26, 0x5f, 0x46, 0x18, 0x05, 0x61, 0x10, 0xbb, 0x13, 0x85,
-	0x20, 0x35, 0x58, 0x87, 0x50, 0xe5, 0x17, 0x34, 0x4f, 0x0c, 0xa8, 0x43, 0x61, 0x4f, 0x35, 0x5f,
-	0x05, 0x87, 0x2f, 0xd0, 0x6d, 0x58, 0xe9, 0xcd, 0x65, 0x72, 0xdb, 0x90, 0x26, 0xf5, 0xe6, 0xda,
-	0xa1, 0xe8, 0x06, 0xeb, 0xdf, 0x06, 0xdc, 0xe8, 0xa8, 0x7e, 0x94, 0xb9, 0x4b, 0x02, 0xb3, 0x50,
-	0x49, 0x87, 0x15, 0xbf, 0xab, 0x2c, 0x19, 0x7d, 0x1b, 0xca, 0x8a, 0xc4, 0x42, 0x2a, 0xc7, 0x3c,
-	0xd1, 0x6c, 0xf3, 0x16, 0xb4, 0x2d, 0x5b, 0xd0, 0x76, 0x4f, 0xb6, 0xa0, 0x9d, 0x12, 0x35, 0xe1,
-	0x37, 0xff, 0x6c, 0x19, 0x4e, 0x5a, 0x14, 0x0d, 0x35, 0xac, 0xe5, 0xe5, 0xb2, 0x34, 0xa6, 0xd5,
-	0x02, 0xb3, 0x37, 0x97, 0xd1, 0x88, 0x20, 0xcf, 0x14, 0xf1, 0x74, 0xcb, 0x7e, 0x5b, 0x77, 0x01,
-	0xe8, 0x06, 0xe1, 0x89, 0x2d, 0xc8, 0xf5, 0xe6, 0xe2, 0x7a, 0x33, 0xbe, 0x74, 0x72, 0xbd, 0xb9,
-	0xf5, 0x1f, 0x03, 0x4c, 0x15, 0x32, 0xe8, 0x6b, 0xf4, 0x33, 0x70, 0x47, 0x58, 0x36, 0x88, 0x48,
-	0x4a, 0xec, 0x33, 0xaa, 0x1e, 0x56, 0x7c, 0x1f, 0xea, 0x40, 0x75, 0xe2, 0xc6, 0xa4, 0x4f, 0x8d,
-	0xf7, 0x48, 0xdf, 0xa3, 0x19, 0x35, 0x97, 0x96, 0xdd, 0x63, 0x2c, 0x4d, 0x76, 0x83, 0x4a, 0x24,
-	0x54, 0xf4, 0x1d, 0xa8, 0x0f, 0x16, 0x3f, 0x73, 0x7d, 0xe2, 0xf9, 0xb8, 0x7f, 0x92, 0xb4, 0x8d,
-	0x2b, 0x2c, 0x02, 0xea, 0x12, 0xe7, 0xc1, 0x89, 0x37, 0xc2, 0xfe, 0x10, 0x6b, 0x48, 0xd7, 0x95,
-	0x5c, 0xd2, 0x44, 0xca, 0xf8, 0xc9, 0x5f, 0x15, 0x3f, 0x7f, 0x33, 0x01, 0x92, 0x73, 0xa1, 0x1f,
-	0x01, 0xb0, 0xe9, 0xa6, 0x7f, 0x2c, 0x3d, 0xfa, 0x99, 0xaf, 0xce, 0x1c, 0xa8, 0x54, 0x67, 0x43,
-	0xf1, 0x44, 0xa4, 0x23, 0xee, 0x9e, 0x8a, 0xea, 0x86, 0x39, 0x59, 0x58, 0x26, 0x77, 0xa1, 0xdb,
-	0x50, 0x62, 0x71, 0xdd, 0xf7, 0x46, 0x2c, 0x8e, 0xcc, 0xce, 0xda, 0xab, 0xf3, 0x96, 0xa8, 0x9a,
-	0xef, 0x3b, 0xc5, 0xa1, 0x28, 0x9f, 0x49, 0xe6, 0xca, 0xb3, 0x64, 0x28, 0x56, 0xe8, 0x3e, 0xe4,
-	0xe9, 0xd4, 0xc4, 0xd2, 0xe9, 0x9b, 0xc6, 0x33, 0x93, 0x40, 0x37, 0xa1, 0xe8, 0xcf, 0xa6, 0x7d,
-	0x32, 0x8f, 0x45, 0x7e, 0x5d, 0xf5, 0x67, 0xd3, 0xde, 0x3c, 0x46, 0x5f, 0x04, 0x93, 0x04, 0xc4,
-	0x9d, 0x30, 0x56, 0x91, 0xb1, 0x4a, 0x8c, 0x40, 0x99, 0x16, 0x94, 0x59, 0x20, 0x70, 0x1f, 0x7a,
-	0xa3, 0x46, 0x89, 0x7a, 0xd0, 0x59, 0x9b, 0xc8, 0xe8, 0x3d, 0x18, 0xa1, 0x71, 0x3a, 0x58, 0x98,
-	0xa3, 0xcd, 0x65, 0x38, 0x5a, 0x8b, 0x28, 0xe6, 0xed, 0x0f, 0xc1, 0x1c, 0xb9, 0xc4, 0xe5, 0x1a,
-	0x60, 0x19, 0x1a, 0x4a, 0x14, 0x8f, 0x61, 0x3f, 0x83, 0x4a, 0x12, 0xa3, 0x5c, 0xc3, 0xda, 0x52,
-	0xce, 0x90, 0xa0, 0x32, 0x3d, 0x01, 0xd4, 0x7d, 0x3c, 0x27, 0xfd, 0xac, 0xb2, 0xf5, 0x65, 0x28,
-	0x43, 0x14, 0xfa, 0x28, 0xad, 0x70, 0x04, 0x1b, 0xaa, 0xb1, 0xe1, 0xaa, 0xca, 0x4b, 0xc9, 0x5f,
-	0x0a, 0x94, 0x69, 0xf9, 0x01, 0x94, 0xdc, 0x30, 0xe4, 0xf8, 0x1b, 0xcb, 0xc0, 0x2f, 0xba, 0x61,
-	0xc8, 0x90, 0x3d, 0xa8, 0xb1, 0xe8, 0x8a, 0x70, 0x3c, 0x9b, 0x10, 0x71, 0x84, 0xca, 0x52, 0x1a,
-	0x17, 0x8a, 0xeb, 0x70, 0x58, 0xa6, 0x6a, 0x00, 0x65, 0x2c, 0xb2, 0x11, 0x57, 0x53, 0x5d, 0x86,
-	0x9a, 0x75, 0x89, 0xc9, 0x74, 0xbc, 0x0d, 0x55, 0x5e, 0x4d, 0x71, 0xd4, 0x77, 0xc5, 0x84, 0x56,
-	0x63, 0x79, 0xbe, 0x22, 0xe9, 0x72, 0xbe, 0xfd, 0x3a, 0x14, 0x45, 0x16, 0xa1, 0x25, 0x35, 0x69,
-	0x29, 0xf2, 0xa2, 0x7f, 0x40, 0x55, 0x58, 0xd9, 0x0d, 0x43, 0xd1, 0x91, 0xd0, 0x9f, 0xd6, 0xef,
-	0x0d, 0x00, 0x2d, 0x05, 0xff, 0x7f, 0x93, 0xdf, 0x5d, 0x28, 0x9c, 0x04, 0xc9, 0xc0, 0x52, 0x55,
-	0xa9, 0x2f, 0x20, 0x49, 0x36, 0x37, 0x1c, 0xbe, 0xc9, 0xfa, 0x8b, 0x01, 0x25, 0xc9, 0x41, 0x5f,
-	0x81, 0x9a, 0xfa, 0x00, 0x94, 0x1b, 0x78, 0xb9, 0xab, 0x2a, 0x86, 0x9c, 0x58, 0x37, 0xc1, 0x8c,
-	0xbd, 0xb1, 0xef, 0x92, 0x59, 0x24, 0xa6, 0x3f, 0x27, 0x21, 0x50, 0xd7, 0x44, 0x74, 0x82, 0x61,
-	0xe9, 0xb4, 0xe0, 0xf0, 0x05, 0xcd, 0x9f, 0xfb, 0xa9, 0xfc, 0xb9, 0xff, 0x19, 0xf3, 0xa7, 0xe5,
-	0x43, 0x39, 0xf5, 0xc2, 0x41, 0x67, 0x9f, 0xb4, 0xe5, 0x72, 0x49, 0x53, 0x6d, 0x38, 0x1b, 0xf4,
-	0x9f, 0x8b, 0x31, 0xdb, 0x74, 0x56, 0x43, 0xde, 0x82, 0xd7, 0xa1, 0x10, 0x06, 0x3f, 0xc5, 0x11,
-	0xb3, 0x75, 0xc5, 0xe1, 0x0b, 0x4a, 0x8d, 0x89, 0xfb, 0x1c, 0x33, 0x53, 0xf3, 0x0e, 0x5f, 0x58,
-	0xdf, 0x82, 0x75, 0xbd, 0x34, 0x7e, 0x82, 0xba, 0xa4, 0x56, 0xe4, 0xf4, 0x5a, 0x61, 0xfd, 0xd2,
-	0x80, 0x55, 0x5e, 0x1f, 0x35, 0x77, 0x18, 0x29, 0x77, 0xc8, 0x4e, 0x23, 0x97, 0x74, 0x1a, 0x54,
-	0x51, 0xd7, 0x8d, 0xbf, 0x17, 0xe3, 0x91, 0x30, 0x53, 0x2e, 0xe9, 0x45, 0x74, 0xdd, 0xf8, 0xfb,
-	0xae, 0x4f, 0xf0, 0x48, 0xf8, 0x35, 0x21, 0xa0, 0x26, 0x94, 0x1e, 0xf8, 0x27, 0x78, 0x12, 0x84,
-	0xdc, 0xbd, 0xa6, 0xa3, 0xd6, 0xf7, 0x3e, 0x06, 0x00, 0x16, 0xb3, 0xac, 0xd0, 0xa1, 0x1f, 0x02,
-	0x74, 0xb1, 0x7c, 0x0f, 0x42, 0x37, 0xd4, 0xd3, 0x57, 0xea, 0xcd, 0xaf, 0x79, 0xd9, 0x93, 0x98,
-	0xd5, 0xfc, 0xc5, 0x3f, 0x3e, 0xfe, 0x5d, 0xae, 0x8e, 0x90, 0x2d, 0x38, 0xf6, 0xa9, 0x10, 0x3d,
-	0x43, 0x07, 0x74, 0x2e, 0x53, 0x4f, 0x4d, 0xa8, 0xac, 0xda, 0x8c, 0x69, 0x48, 0x16, 0xcd, 0x46,
-	0x06, 0x52, 0x35, 0xad, 0x56, 0x8d, 0x61, 0xae, 0x21, 0xd3, 0x56, 0xb2, 0xdc, 0x4a, 0xf1, 0xb4,
-	0x91, 0x58, 0x99, 0x7e, 0x9f, 0x4a, 0xac, 0xcc, 0xbc, 0x45, 0x69, 0x56, 0x0a, 0x8e, 0x66, 0xe5,
-	0x18, 0xd6, 0x13, 0xe8, 0x5d, 0x82, 0x1a, 0x19, 0x10, 0xf5, 0x90, 0xd3, 0xbc, 0xf5, 0x3f, 0x38,
-	0x42, 0x81, 0xc5, 0x14, 0x6c, 0xa2, 0xa6, 0xad, 0x78, 0x89, 0x0a, 0xfb, 0xf4, 0x21, 0x5e, 0x9c,
-	0xa1, 0x3e, 0x53, 0xa4, 0x02, 0xf7, 0x52, 0x5f, 0xdf, 0xba, 0xf0, 0x8a, 0xa7, 0xd4, 0x6c, 0x32,
-	0x35, 0x37, 0x50, 0xdd, 0x56, 0x3c, 0xed, 0x24, 0x4f, 0xa0, 0xac, 0x2b, 0xb8, 0xe0, 0xf1, 0xe6,
-	0x05, 0xe0, 0xc4, 0xe7, 0xd7, 0x19, 0x72, 0x19, 0xad, 0xd9, 0x9a, 0xfc, 0x1e, 0x98, 0xcc, 0x35,
-	0x74, 0xb2, 0xcf, 0x82, 0x69, 0x77, 0xa0, 0x0f, 0xfe, 0x56, 0x85, 0x01, 0x99, 0xa8, 0x68, 0x0b,
-	0xb9, 0x0f, 0xd8, 0xd5, 0x89, 0x21, 0x25, 0x8b, 0x72, 0xd9, 0x3c, 0x64, 0x55, 0x19, 0x0c, 0xa0,
-	0x92, 0x2d, 0x25, 0xdf, 0x67, 0x38, 0xa2, 0x3b, 0xcb, 0xe2, 0xa8, 0x97, 0xcf, 0xd4, 0x8b, 0x89,
-	0x86, 0x22, 0xe5, 0x1e, 0xc1, 0x46, 0x17, 0x13, 0x6d, 0x9c, 0xbd, 0x14, 0x29, 0x35, 0x48, 0x5a,
-	0x75, 0x86, 0xb4, 0x81, 0xd6, 0x6d, 0x5d, 0xf6, 0x08, 0x6a, 0xd4, 0x26, 0x59, 0x7e, 0xf9, 0x3b,
-	0x52, 0x06, 0xf0, 0xf2, 0xc7, 0x23, 0xeb, 0x26, 0x03, 0xad, 0xa1, 0x8a, 0x9d, 0x81, 0x38, 0x84,
-	0x52, 0x17, 0x0b, 0x1d, 0xf5, 0x8c, 0x41, 0x3c, 0x48, 0x2e, 0x31, 0x33, 0x41, 0x64, 0x74, 0xfb,
-	0x94, 0xe7, 0x9f, 0x33, 0x34, 0x64, 0x57, 0xc9, 0x27, 0x55, 0x94, 0x16, 0x56, 0x81, 0x77, 0x23,
-	0x4b, 0x16, 0xa0, 0x6f, 0x31, 0xd0, 0x2f, 0xa1, 0x16, 0x07, 0x8d, 0xed, 0x53, 0x35, 0x8f, 0x9f,
-	0xd9, 0xa7, 0x6a, 0xfa, 0x3e, 0x43, 0x3f, 0x66, 0xee, 0x48, 0x4f, 0x8c, 0x59, 0x77, 0x6c, 0xa5,
-	0x94, 0x5c, 0x18, 0x2c, 0xb5, 0x4f, 0xf5, 0x22, 0x54, 0x07, 0x0a, 0x5d, 0x4c, 0x7a, 0x73, 0x54,
-	0x4b, 0x66, 0x0e, 0x69, 0x3c, 0xd2, 0x49, 0x02, 0x0b, 0x31, 0xac, 0x75, 0x04, 0x76, 0x6f, 0x6e,
-	0x9f, 0xd2, 0x8c, 0x7a, 0x86, 0x9e, 0xb1, 0xa4, 0x24, 0x07, 0xe5, 0x4b, 0xbc, 0xdb, 0x48, 0x51,
-	0xb5, 0x81, 0x5a, 0xf9, 0xc2, 0xb4, 0x25, 0xeb, 0x43, 0x6a, 0xab, 0x5c, 0x28, 0x87, 0x77, 0x1a,
-	0x2f, 0x5e, 0x6d, 0x5d, 0xfb, 0xfb, 0xab, 0xad, 0x6b, 0xff, 0x7a, 0xb5, 0x65, 0xfc, 0xe1, 0xf5,
-	0xd6, 0xb5, 0xbf, 0xbe, 0xde, 0x32, 0x5e, 0xbc, 0xde, 0x32, 0x06, 0xe2, 0x1f, 0x3a, 0xff, 0x0d,
-	0x00, 0x00, 0xff, 0xff, 0xd6, 0x68, 0x24, 0x81, 0xfb, 0x19, 0x00, 0x00,
+	golang_proto.RegisterFile("rpc/grpc/proto3/blockchain.proto", fileDescriptor_blockchain_4c448eeaa4e1a0c1)
+}
+
+var fileDescriptor_blockchain_4c448eeaa4e1a0c1 = []byte{
+	// 2619 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcf, 0x73, 0x23, 0x47,
+	0xf5, 0xdf, 0xd1, 0x0f, 0x4b, 0x7a, 0xb2, 0x24, 0xab, 0x57, 0xf1, 0x6a, 0x15, 0xc7, 0x76, 0x26,
+	0xf5, 0x4d, 0x36, 0xf9, 0x2e, 0x9e, 0xb0, 0x4b, 0x2a, 0x5b, 0x40, 0x0a, 0x56, 0x9b, 0x5d, 0x7b,
+	0x89, 0xb3, 0x76, 0xc6, 0x62, 0x81, 0x40, 0xa1, 0x1a, 0x49, 0xbd, 0xf2, 0xb0, 0xd2, 0xcc, 0x30,
+	0xd3, 0x32, 0x12, 0x2e, 0x73, 0x80, 0x13, 0x55, 0xa4, 0x8a, 0x1f, 0x17, 0x8e, 0x39, 0x72, 0xa5,
+	0x38, 0x71, 0xa0, 0x8a, 0x1b, 0xe1, 0x46, 0x15, 0xb7, 0x1c, 0x16, 0x6a, 0xc3, 0x7f, 0xc0, 0x85,
+	0x23, 0xd5, 0x3f, 0xa7, 0x67, 0x64, 0xc5, 0x09, 0x11, 0x07, 0x2e, 0xae, 0x79, 0xef, 0x75, 0x7f,
+	0xde, 0xeb, 0xd7, 0xaf, 0x5f, 0xbf, 0x7e, 0x32, 0x6c, 0x87, 0x41, 0xdf, 0x1a, 0xd2, 0x3f, 0x41,
+	0xe8, 0x13, 0xff, 0xa6, 0xd5, 0x1b, 0xf9, 0xfd, 0xc7, 0xfd, 0x63, 0xc7, 0xf5, 0x76, 0x18, 0x07,
+	0xad, 0x70, 0x41, 0xeb, 0x73, 0x43, 0x97, 0x1c, 0x4f, 0x7a, 0x3b, 0x7d, 0x7f, 0x6c, 0x0d, 0xfd,
+	0xa1, 0xcf, 0x27, 0xf4, 0x26, 0x8f, 0x18, 0xc5, 0x08, 0xf6, 0xc5, 0xa7, 0xb5, 0x36, 0x86, 0xbe,
+	0x3f, 0x1c, 0x61, 0xcb, 0x09, 0x5c, 0xcb, 0xf1, 0x3c, 0x9f, 0x38, 0xc4, 0xf5, 0xbd, 0x48, 0x48,
+	0xb7, 0x84, 0x54, 0x61, 0x10, 0x77, 0x8c, 0x23, 0xe2, 0x8c, 0x03, 0x3e, 0xc0, 0x2c, 0x40, 0xfe,
+	0xee, 0x38, 0x20, 0x33, 0xf3, 0x15, 0xa8, 0xde, 0x1e, 0x0c, 0x42, 0x1c, 0x45, 0x36, 0xfe, 0xfe,
+	0x04, 0x47, 0x04, 0x35, 0xa1, 0x20, 0x38, 0x4d, 0x63, 0xdb, 0xb8, 0x56, 0xb2, 0x25, 0x69, 0x9e,
+	0x41, 0xed, 0x76, 0xbf, 0xef, 0x4f, 0x3c, 0x62, 0xe3, 0x28, 0xf0, 0xbd, 0x08, 0xa3, 0xef, 0x41,
+	0x41, 0xb0, 0xd8, 0xe0, 0xf2, 0x8d, 0x2b, 0x5c, 0xc1, 0xcd, 0x9d, 0xd4, 0xc8, 0xf6, 0xeb, 0x1f,
+	0x3e, 0xd9, 0xba, 0xa9, 0xaf, 0xd1, 0x19, 0x8d, 0x9c, 0x3e, 0x71, 0xfb, 0xda, 0x57, 0xdf, 0x0f,
+	0xb1, 0xe5, 0xf0, 0x89, 0x0a, 0x40, 0x2a, 0x30, 0xdf, 0x81, 0xf2, 0xa1, 0x33, 0xc4, 0xd2, 0xce,
+	0x75, 0x58, 0xb9, 0x33, 0x09, 0x23, 0x3f, 0x14, 0x66, 0x0a, 0x0a, 0x35, 0x20, 0xbf, 0xef, 0x8e,
+	0x5d, 0xd2, 0xcc, 0x6c, 0x1b, 0xd7, 0xf2, 0x36, 0x27, 0x28, 0xf7, 0x20, 0x1c, 0xe0, 0xb0, 0x99,
+	0x65, 0x83, 0x39, 0x61, 0x8e, 0x01, 0xee, 0xb9, 0x23, 0x82, 0xc3, 0x37, 0x1d, 0xe2, 0xd0, 0x31,
+	0xf7, 0x5c, 0x3c, 0x1a, 0x08, 0x40, 0x4e, 0xa0, 0x2a, 0x64, 0x0e, 0x02, 0x06, 0x56, 0xb2, 0x33,
+	0x07, 0x01, 0x1d, 0xf5, 0xd0, 0x19, 0x4d, 0xb0, 0x44, 0x62, 0x04, 0x7a, 0x01, 0x32, 0x07, 0x61,
+	0x33, 0xb7, 0x9d, 0xbd, 0x56, 0xbe, 0x71, 0x59, 0xfa, 0x80, 0x63, 0xef, 0x86, 0xfe, 0x24, 0xb0,
+	0x33, 0x07, 0xa1, 0xf9, 0x25, 0x28, 0x6b, 0x2c, 0x74, 0x1d, 0x0a, 0x9c, 0xa4, 0x9e, 0xa6, 0x13,
+	0x51, 0x72, 0x22, 0x35, 0xca, 0x96, 0x43, 0xcc, 0x9f, 0x18, 0xca, 0xfd, 0xd1, 0x12, 0x7d, 0xa0,
+	0x5b, 0x91, 0xbb, 0xd8, 0x8a, 0x9f, 0x1a, 0xb0, 0x16, 0x5b, 0x21, 0xa2, 0x60, 0x1b, 0xca, 0x6d,
+	0x1a, 0xd7, 0x7b, 0xd8, 0x1d, 0x1e, 0xf3, 0x48, 0xc8, 0xd9, 0x3a, 0x0b, 0xdd, 0x84, 0xa2, 0x9c,
+	0xd5, 0xcc, 0x6c, 0x67, 0x3f, 0x26, 0x50, 0x6c, 0x35, 0x10, 0x6d, 0x02, 0x3c, 0xc0, 0x53, 0x22,
+	0x56, 0xc8, 0x8d, 0xd6, 0x38, 0xe6, 0x1e, 0xd4, 0x1f, 0x3a, 0x23, 0x77, 0xe0, 0x10, 0x3f, 0x54,
+	0xb6, 0xdc, 0x84, 0x92, 0x62, 0x8a, 0x98, 0x7c, 0x46, 0xaa, 0x52, 0x82, 0xfb, 0xde, 0x23, 0xdf,
+	0x8e, 0xc7, 0x99, 0x1d, 0x0d, 0x49, 0x39, 0xf7, 0x25, 0xc8, 0xd1, 0x78, 0x13, 0x20, 0x6a, 0x53,
+	0xb5, 0x18, 0xb4, 0xd9, 0x00, 0xba, 0x0b, 0x47, 0xc4, 0x21, 0x93, 0x48, 0x44, 0x89, 0xa0, 0xcc,
+	0xf7, 0x0c, 0x40, 0x3a, 0xec, 0x27, 0xf6, 0xd6, 0x6b, 0x00, 0xf1, 0x3c, 0xe1, 0xaf, 0x05, 0x8b,
+	0xd0, 0x06, 0x5e, 0xe8, 0xaf, 0x63, 0x68, 0xa8, 0xd1, 0x47, 0x38, 0x3e, 0xc4, 0xeb, 0xb0, 0x92,
+	0xb0, 0x45, 0x50, 0x14, 0x2f, 0x65, 0x46, 0x29, 0xa1, 0xaf, 0x09, 0x85, 0x7d, 0xec, 0x9c, 0xd0,
+	0xc8, 0xc9, 0x32, 0xa1, 0x24, 0xcd, 0x2f, 0x43, 0x55, 0x8d, 0xbb, 0x7b, 0x82, 0x3d, 0xb2, 0x50,
+	0x07, 0x82, 0x5c, 0x67, 0x16, 0x60, 0xe1, 0x39, 0xf6, 0x6d, 0x0e, 0xa0, 0xa9, 0x66, 0xef, 0xb9,
+	0x11, 0xf1, 0xc3, 0x99, 0xb2, 0x75, 0x61, 0x76, 0x42, 0x3b, 0xb0, 0xc2, 0x54, 0x49, 0x87, 0xad,
+	0xcf, 0x39, 0x8c, 0x89, 0x6d, 0x31, 0xca, 0x7c, 0x19, 0xea, 0xfb, 0x6e, 0x44, 0x64, 0xb4, 0x1d,
+	0x3a, 0xa1, 0x33, 0xa6, 0x47, 0xe4, 0x9d, 0x09, 0x0e, 0x67, 0x32, 0x05, 0x30, 0x82, 0x26, 0xc9,
+	0x23, 0xe2, 0x87, 0x5a, 0xf2, 0x59, 0x9c, 0x24, 0x0f, 0xa1, 0xa6, 0xc6, 0x0a, 0x9b, 0xdf, 0x80,
+	0x55, 0xc1, 0xba, 0x4f, 0xf0, 0x58, 0x1e, 0x76, 0x15, 0x50, 0x9a, 0xac, 0x9d, 0xfb, 0xe0, 0xc9,
+	0xd6, 0x25, 0x3b, 0x31, 0xdc, 0xfc, 0x9d, 0x01, 0x65, 0x8d, 0x81, 0x0e, 0x20, 0xfb, 0x16, 0xe6,
+	0x16, 0xae, 0xb6, 0xdf, 0xa0, 0x13, 0x3e, 0x7c, 0xb2, 0xf5, 0xda, 0x85, 0xa9, 0x75, 0x3c, 0xf6,
+	0x3d, 0xab, 0xe7, 0x7a, 0x4e, 0x38, 0xdb, 0xd9, 0xc3, 0xd3, 0xf6, 0x8c, 0xe0, 0xc8, 0xa6, 0x48,
+	0xe8, 0x48, 0x66, 0xb4, 0xcc, 0x32, 0x20, 0x39, 0x96, 0x79, 0x06, 0x6b, 0xc2, 0xe8, 0xdb, 0xe4,
+	0x42, 0xaf, 0xc9, 0x35, 0x65, 0x96, 0xb5, 0x26, 0xf3, 0xf7, 0x06, 0xd4, 0x35, 0xfd, 0x62, 0x27,
+	0xfe, 0x37, 0x5c, 0xf7, 0x5e, 0x06, 0xea, 0x77, 0xa8, 0xbd, 0x5e, 0x34, 0x89, 0xd3, 0x86, 0x0b,
+	0x60, 0xfb, 0x13, 0x6f, 0x40, 0x93, 0x0b, 0x16, 0x4b, 0xb8, 0x2f, 0xf4, 0xdd, 0xd6, 0xf4, 0x11,
+	0xec, 0x0d, 0x70, 0x38, 0x76, 0x3d, 0xa2, 0x7f, 0xf6, 0x25, 0x9e, 0x45, 0x66, 0x01, 0x8e, 0x76,
+	0x62, 0xa8, 0x23, 0x77, 0x1c, 0x8c, 0xb0, 0xad, 0x81, 0xa3, 0x9f, 0x19, 0x50, 0x3b, 0xc4, 0x38,
+	0x8c, 0x59, 0xf2, 0x50, 0x5d, 0x95, 0x41, 0x3b, 0x67, 0x5f, 0x7b, 0x57, 0xd8, 0xf2, 0x95, 0x4f,
+	0x6d, 0x4b, 0x52, 0x95, 0x9d, 0x56, 0x6d, 0xfe, 0xc6, 0x80, 0xca, 0x1d, 0x5a, 0x32, 0x29, 0x5f,
+	0x6c, 0x40, 0x89, 0x31, 0x1e, 0x38, 0x63, 0x2c, 0x42, 0x29, 0x66, 0xd0, 0x30, 0x63, 0xc4, 0xfd,
+	0x81, 0x48, 0x2b, 0x92, 0x44, 0x5d, 0x28, 0xef, 0x62, 0x0f, 0x47, 0x6e, 0xb4, 0xe7, 0x44, 0xc7,
+	0xcd, 0xec, 0x32, 0x36, 0x4d, 0x47, 0x34, 0x7f, 0x91, 0x83, 0x2a, 0xcf, 0xfe, 0x5a, 0x89, 0x54,
+	0x7c, 0xe0, 0x0f, 0x30, 0xcd, 0xd6, 0x62, 0xd7, 0x1e, 0x08, 0x85, 0xf7, 0x3e, 0x49, 0x39, 0xa4,
+	0x39, 0x2b, 0xf6, 0x60, 0x70, 0x23, 0xd8, 0xd9, 0x95, 0xa8, 0xb6, 0xc2, 0x4f, 0xaf, 0x2f, 0xb3,
+	0xec, 0xf5, 0xa1, 0x03, 0x58, 0x39, 0x9c, 0xf4, 0xe8, 0x19, 0xe2, 0xbe, 0x7b, 0x5d, 0x60, 0x5b,
+	0x17, 0x61, 0x87, 0xb3, 0x80, 0xf8, 0x3b, 0x87, 0x93, 0xde, 0xc8, 0xed, 0xbf, 0x85, 0x67, 0xb6,
+	0x80, 0x41, 0x43, 0xa8, 0xed, 0xd3, 0x4d, 0x26, 0xfc, 0xfe, 0xa3, 0x56, 0xe7, 0x96, 0x61, 0x75,
+	0x1a, 0x15, 0x5d, 0x87, 0xba, 0xce, 0xe2, 0x77, 0x51, 0x9e, 0xdd, 0x45, 0xf3, 0x02, 0x74, 0x2d,
+	0x61, 0x56, 0xc7, 0x1d, 0xe3, 0xe6, 0xca, 0xb6, 0x71, 0x2d, 0x6b, 0xa7, 0xd9, 0xf4, 0x36, 0xa7,
+	0xee, 0x7f, 0x88, 0xc3, 0xc8, 0xf5, 0xbd, 0x66, 0x81, 0x05, 0x9c, 0xce, 0x32, 0x5f, 0x84, 0x55,
+	0x36, 0x5c, 0x2b, 0xda, 0x8e, 0x13, 0x57, 0x21, 0xa7, 0x4c, 0x02, 0x15, 0x36, 0x4e, 0x15, 0x20,
+	0x1b, 0x50, 0x1a, 0xbb, 0x5e, 0xe2, 0xda, 0x8c, 0x19, 0x4c, 0xea, 0x4c, 0x85, 0x34, 0x23, 0xa4,
+	0x92, 0x41, 0x8b, 0x97, 0x80, 0x16, 0x2f, 0xd9, 0x8f, 0x29, 0x5e, 0xe8, 0x00, 0xf3, 0x16, 0xac,
+	0x1f, 0x4d, 0x7a, 0x51, 0x3f, 0x74, 0x7b, 0x38, 0xa9, 0x7e, 0x13, 0xe0, 0x5e, 0xe8, 0x8f, 0x13,
+	0xfa, 0x35, 0x8e, 0x79, 0x4b, 0xd8, 0xab, 0x22, 0xfd, 0x25, 0xc8, 0x33, 0x86, 0xa8, 0x98, 0xea,
+	0x52, 0x29, 0x63, 0xb2, 0x48, 0xe5, 0x72, 0xd3, 0x81, 0xaa, 0x54, 0x25, 0xa6, 0x5a, 0xb0, 0xc2,
+	0x39, 0xe2, 0x72, 0x9c, 0x9f, 0x2b, 0xae, 0x46, 0x31, 0x2c, 0x55, 0xeb, 0x64, 0xe6, 0x6a, 0x9d,
+	0x1f, 0x41, 0x4d, 0xc4, 0xad, 0xd2, 0xf1, 0x18, 0x0a, 0x82, 0x95, 0x7e, 0xab, 0xa4, 0x46, 0xb6,
+	0x6f, 0x7d, 0xf8, 0x64, 0xeb, 0x0b, 0x9f, 0xe4, 0x70, 0x06, 0xa1, 0x1f, 0xf8, 0x91, 0x33, 0x52,
+	0x08, 0x52, 0x83, 0x79, 0x08, 0x6b, 0x3c, 0x46, 0xa6, 0xb1, 0x01, 0x0d, 0xc8, 0xdf, 0x51, 0x4f,
+	0xa5, 0xbc, 0xcd, 0x09, 0xf4, 0x22, 0x64, 0x3b, 0x53, 0x99, 0x5f, 0xab, 0xd2, 0xa4, 0xce, 0x54,
+	0x5b, 0x34, 0x1d, 0x60, 0xfe, 0xd3, 0x80, 0xf5, 0xb6, 0x7a, 0x3d, 0x32, 0x77, 0x4a, 0x60, 0x16,
+	0xad, 0xc9, 0xc8, 0xe6, 0xdb, 0x95, 0x66, 0xa3, 0xaf, 0x41, 0x65, 0xdf, 0xd1, 0xc2, 0x97, 0x79,
+	0xae, 0x7c, 0xa3, 0xb5, 0xc3, 0x1f, 0x8c, 0x3b, 0xf2, 0xc1, 0xb8, 0xd3, 0x91, 0x0f, 0xc6, 0x76,
+	0x91, 0x9a, 0xf0, 0xf3, 0xbf, 0x6d, 0x19, 0x76, 0x72, 0x2a, 0xea, 0x6b, 0x58, 0xcb, 0x4b, 0xa7,
+	0x49, 0x4c, 0x73, 0x0b, 0x4a, 0x9d, 0xa9, 0x8c, 0x48, 0x04, 0x39, 0xa6, 0x88, 0x67, 0x7c, 0xf6,
+	0x6d, 0x5e, 0x07, 0xe8, 0x4c, 0x95, 0x27, 0x36, 0x21, 0xd3, 0x99, 0x8a, 0xed, 0x4d, 0xf9, 0xd2,
+	0xce, 0x74, 0xa6, 0xe6, 0x2f, 0x0d, 0xa8, 0x8b, 0x8a, 0x8f, 0xed, 0xcc, 0x45, 0x75, 0x49, 0xf2,
+	0x0c, 0x64, 0xd2, 0x67, 0x00, 0xb5, 0xa0, 0xd8, 0xf1, 0x85, 0x34, 0xcb, 0xa4, 0x8a, 0x56, 0xef,
+	0x87, 0xdc, 0x05, 0xef, 0x07, 0x73, 0x06, 0x25, 0x65, 0xd3, 0xc2, 0x42, 0xb9, 0x01, 0xf9, 0xfb,
+	0xde, 0x00, 0x4f, 0x99, 0x11, 0x15, 0x9b, 0x13, 0xca, 0x23, 0xd9, 0xd8, 0x23, 0xaa, 0xa4, 0xce,
+	0xc5, 0x25, 0xb5, 0xf6, 0x44, 0xc9, 0xb3, 0xd8, 0x13, 0x94, 0x19, 0x01, 0xd2, 0xdd, 0x71, 0x61,
+	0x91, 0xfd, 0x82, 0x1e, 0xac, 0xf5, 0xd4, 0x13, 0xae, 0x33, 0x65, 0x91, 0x7a, 0xe1, 0x3b, 0xe4,
+	0x5f, 0x06, 0x94, 0xd4, 0xb9, 0x46, 0xaf, 0xd2, 0x74, 0xe8, 0xd0, 0x67, 0x29, 0xdf, 0x36, 0xf5,
+	0xfc, 0xdc, 0x63, 0x5c, 0xfd, 0xec, 0xf3, 0x71, 0xa8, 0x0d, 0x6b, 0x23, 0x27, 0x22, 0x5d, 0x1a,
+	0x41, 0x2e, 0xe9, 0xba, 0xf4, 0x66, 0xcd, 0x24, 0xe7, 0xde, 0x61, 0x22, 0x6d, 0x6e, 0x95, 0xce,
+	0x88, 0xb9, 0xe8, 0x6d, 0x68, 0xf4, 0x66, 0x3f, 0x74, 0x3c, 0xe2, 0x7a, 0xb8, 0x7b, 0x12, 0xbf,
+	0x72, 0xb2, 0x6c, 0x65, 0x0d, 0x89, 0x73, 0xf7, 0xc4, 0x1d, 0x60, 0xaf, 0x8f, 0x35, 0xa4, 0xcb,
+	0x6a, 0x9e, 0xf6, 0x14, 0x12, 0x87, 0x38, 0x77, 0xd1, 0x21, 0xfe, 0x53, 0x09, 0x20, 0x5e, 0x17,
+	0xfa, 0x0e, 0x00, 0x6b, 0x08, 0x75, 0x8f, 0x65, 0x58, 0x7f, 0xe6, 0xf3, 0x53, 0xea, 0xa9, 0x2b,
+	0xcf, 0x82, 0xc2, 0x89, 0xb8, 0x96, 0xb8, 0x7b, 0x6a, 0xea, 0x49, 0xc4, 0xd9, 0xc2, 0x32, 0x39,
+	0x0a, 0xbd, 0x08, 0x45, 0x96, 0x5c, 0xba, 0xee, 0x80, 0x6f, 0x5b, 0xbb, 0xfc, 0xf4, 0xc9, 0x96,
+	0xa8, 0x9e, 0xde, 0xb4, 0x0b, 0x7d, 0x51, 0x46, 0xc5, 0x37, 0x58, 0x8e, 0x5d, 0x8a, 0x82, 0x42,
+	0xb7, 0x20, 0x47, 0x1b, 0x4d, 0xcd, 0xfc, 0xa7, 0x48, 0x2a, 0x6c, 0x06, 0xba, 0x02, 0x05, 0x6f,
+	0x32, 0xee, 0x92, 0x69, 0x24, 0xee, 0xd9, 0x15, 0x6f, 0x32, 0xa6, 0xb1, 0xf4, 0x2c, 0x94, 0x88,
+	0x4f, 0x9c, 0x11, 0x13, 0x15, 0x98, 0xa8, 0xc8, 0x18, 0x54, 0x68, 0x42, 0x85, 0x05, 0x02, 0xf7,
+	0xa1, 0x3b, 0x68, 0x16, 0xa9, 0x07, 0xed, 0xf2, 0x48, 0xa6, 0x90, 0xfb, 0x03, 0x34, 0x4c, 0x06,
+	0x0b, 0x73, 0x74, 0x69, 0x19, 0x8e, 0xd6, 0x22, 0x8a, 0x79, 0xfb, 0x5d, 0x28, 0x0d, 0x1c, 0xe2,
+	0x70, 0x0d, 0xb0, 0x0c, 0x0d, 0x45, 0x8a, 0xc7, 0xb0, 0x1f, 0x41, 0x2d, 0x8e, 0x51, 0xae, 0xa1,
+	0xbc, 0x94, 0x35, 0xc4, 0xa8, 0x4c, 0x8f, 0x0f, 0x0d, 0x0f, 0x4f, 0x49, 0x37, 0xad, 0x6c, 0x75,
+	0x19, 0xca, 0x10, 0x85, 0x7e, 0x98, 0x54, 0x38, 0x80, 0xaa, 0x2a, 0x70, 0xb9, 0xaa, 0xca, 0x52,
+	0x2e, 0x11, 0x05, 0xca, 0xb4, 0x7c, 0x13, 0x8a, 0x4e, 0x10, 0x70, 0xfc, 0xea, 0x32, 0xf0, 0x0b,
+	0x4e, 0x10, 0x30, 0x64, 0x17, 0xea, 0x2c, 0xba, 0x42, 0x1c, 0x4d, 0x46, 0x44, 0x2c, 0xa1, 0xb6,
+	0x94, 0x02, 0x96, 0xe2, 0xda, 0x1c, 0x96, 0xa9, 0xea, 0x41, 0x05, 0x8b, 0x6c, 0xc4, 0xd5, 0xac,
+	0x2d, 0x43, 0xcd, 0xaa, 0xc4, 0x64, 0x3a, 0x5e, 0x86, 0x35, 0x5e, 0xd2, 0xe0, 0xb0, 0xeb, 0x88,
+	0x1b, 0xa0, 0xce, 0xf2, 0x77, 0x4d, 0xf2, 0x65, 0x9f, 0xe3, 0xf3, 0x50, 0x10, 0x59, 0x84, 0x5e,
+	0x4d, 0x71, 0xdd, 0x97, 0x13, 0x45, 0x1e, 0x5a, 0x83, 0xec, 0xed, 0x20, 0x10, 0x77, 0x26, 0xfd,
+	0x34, 0x7f, 0x6d, 0x00, 0x68, 0x29, 0xf8, 0xbf, 0x9b, 0xfc, 0xae, 0x43, 0xfe, 0xc4, 0x8f, 0x1f,
+	0xae, 0x6b, 0x2a, 0xf5, 0xf9, 0x24, 0xce, 0xe6, 0x86, 0xcd, 0x07, 0x99, 0x7f, 0x30, 0xa0, 0x28,
+	0x25, 0xe8, 0xff, 0xa1, 0xae, 0x0e, 0x80, 0x72, 0x03, 0xbf, 0x08, 0xd7, 0x94, 0x40, 0xde, 0x88,
+	0x1b, 0x50, 0x8a, 0xdc, 0xa1, 0xe7, 0x90, 0x49, 0x28, 0xba, 0x00, 0x76, 0xcc, 0xa0, 0xae, 0x09,
+	0xe9, 0x4b, 0x96, 0xa5, 0xd3, 0xbc, 0xcd, 0x09, 0xed, 0x8e, 0x17, 0xf9, 0x73, 0xef, 0x33, 0xe6,
+	0x4f, 0xf3, 0xb7, 0x06, 0x54, 0x12, 0x8d, 0x41, 0x7a, 0x87, 0x27, 0x4d, 0x97, 0x24, 0xcd, 0xb5,
+	0xc1, 0xa4, 0xd7, 0x7d, 0x2c, 0xfa, 0x2d, 0x25, 0x7b, 0x25, 0xe0, 0x6f, 0xb1, 0x06, 0xe4, 0x03,
+	0xff, 0x07, 0xa2, 0x3f, 0x9c, 0xb5, 0x39, 0x41, 0xb9, 0x11, 0x71, 0x1e, 0xf3, 0x7a, 0x22, 0x67,
+	0x73, 0x02, 0xfd, 0x1f, 0x54, 0x7b, 0xbe, 0x37, 0x70, 0xbd, 0x61, 0xf7, 0x58, 0x7f, 0x4b, 0x55,
+	0x04, 0x37, 0xae, 0x8f, 0x22, 0x5a, 0xeb, 0x78, 0x7d, 0xfe, 0x80, 0xca, 0xd9, 0x8a, 0x36, 0xbf,
+	0x0a, 0xab, 0xfa, 0xf5, 0xfa, 0x31, 0x16, 0xc7, 0xf7, 0x4d, 0x46, 0xbf, 0x6f, 0xcc, 0xf7, 0x0d,
+	0x58, 0xe1, 0x77, 0x6c, 0xaa, 0x6c, 0xca, 0xea, 0xfd, 0x45, 0xf5, 0x14, 0x96, 0x05, 0x52, 0x13,
+	0x0a, 0xbb, 0x4e, 0xf4, 0xf5, 0x08, 0x0f, 0xc4, 0x4a, 0x25, 0x49, 0x37, 0x73, 0xd7, 0x89, 0xbe,
+	0xe1, 0x78, 0x04, 0x0f, 0xc4, 0xde, 0xc4, 0x0c, 0xba, 0x98, 0xbb, 0xde, 0x09, 0x1e, 0xf9, 0x01,
+	0xdf, 0xa2, 0x92, 0xad, 0x68, 0xad, 0xc0, 0x5a, 0xd1, 0x0b, 0xac, 0x1b, 0x7f, 0xae, 0x01, 0xb0,
+	0xf3, 0xc0, 0x2e, 0x51, 0xf4, 0x2d, 0x80, 0x5d, 0x2c, 0x7b, 0x8e, 0x48, 0xb5, 0x28, 0x93, 0x3f,
+	0xc1, 0xb4, 0x16, 0xf5, 0xc6, 0xcd, 0xd6, 0x8f, 0xff, 0xfa, 0x8f, 0x5f, 0x65, 0x1a, 0x08, 0x59,
+	0x42, 0x62, 0x9d, 0x8a, 0xa9, 0x67, 0x68, 0x08, 0xf5, 0x5d, 0x4c, 0xde, 0xc6, 0xe3, 0xc0, 0xf7,
+	0x47, 0xff, 0xb1, 0x86, 0xe7, 0x99, 0x86, 0x67, 0xd1, 0x55, 0x2b, 0x89, 0xa4, 0x29, 0xea, 0x43,
+	0x39, 0x5e, 0x43, 0x84, 0xd2, 0x50, 0x4a, 0x47, 0x73, 0x5e, 0x90, 0x52, 0x52, 0x92, 0xcb, 0x88,
+	0xde, 0xad, 0x9a, 0x31, 0xf1, 0x45, 0xe3, 0x15, 0xe1, 0x28, 0xd1, 0xc1, 0x8b, 0x97, 0x91, 0x6c,
+	0xc3, 0xb6, 0xae, 0xcc, 0xf1, 0xe7, 0x1c, 0x25, 0x24, 0x09, 0x47, 0xad, 0xc6, 0xd0, 0xb7, 0x09,
+	0x6a, 0xa6, 0x40, 0x54, 0xbf, 0xb2, 0x75, 0xf5, 0x1c, 0x89, 0x50, 0x60, 0x32, 0x05, 0x1b, 0xa8,
+	0x65, 0x29, 0x59, 0xac, 0xc2, 0x3a, 0x7d, 0x0b, 0xcf, 0xce, 0x50, 0x97, 0x29, 0x52, 0xc7, 0x72,
+	0xe1, 0x66, 0x5c, 0x9d, 0xeb, 0x54, 0x2b, 0x35, 0x1b, 0x4c, 0xcd, 0x3a, 0x6a, 0x58, 0x4a, 0xa6,
+	0xad, 0xe4, 0xdb, 0x50, 0xd1, 0x15, 0x44, 0x68, 0x1e, 0x49, 0x29, 0x69, 0x9d, 0x27, 0x12, 0x5a,
+	0x2e, 0x33, 0x2d, 0x15, 0x54, 0xb6, 0x34, 0x2c, 0x0c, 0x35, 0x1d, 0xfc, 0x08, 0x13, 0xd4, 0x48,
+	0xbc, 0xca, 0x25, 0xf2, 0xc6, 0x1c, 0xb2, 0xf6, 0xe3, 0x82, 0xb9, 0xc9, 0xb0, 0x9b, 0x68, 0xdd,
+	0xd2, 0xc5, 0xd6, 0x29, 0x3f, 0xc2, 0x67, 0x88, 0xc0, 0x65, 0x5d, 0x8d, 0xe8, 0xf7, 0x2f, 0xf4,
+	0xd5, 0xf6, 0x9c, 0xb2, 0xd4, 0x2f, 0x04, 0xe6, 0x0b, 0x4c, 0xe1, 0x73, 0xe8, 0x59, 0x2b, 0x3d,
+	0x44, 0xf3, 0xdc, 0x1d, 0x28, 0xb1, 0x18, 0xa0, 0x67, 0x14, 0x55, 0x54, 0xb5, 0x4f, 0x7f, 0x12,
+	0x6d, 0x69, 0xc1, 0xa6, 0x37, 0xf2, 0xcc, 0x1a, 0x03, 0x2e, 0xa1, 0x82, 0x25, 0xe6, 0xdd, 0x63,
+	0x31, 0x2a, 0x5e, 0xfc, 0x69, 0x94, 0x45, 0xcd, 0x05, 0x73, 0x8d, 0xc1, 0x00, 0x2a, 0x5a, 0x72,
+	0xe6, 0x9b, 0x0c, 0x47, 0x54, 0xd9, 0x69, 0x1c, 0xf5, 0xbb, 0x4f, 0xa2, 0x03, 0xaa, 0xa1, 0xc8,
+	0x79, 0xfb, 0x50, 0xdd, 0xc5, 0x44, 0x6b, 0x4f, 0x2d, 0x44, 0x4a, 0x74, 0x6d, 0xcc, 0x06, 0x43,
+	0xaa, 0xa2, 0x55, 0x4b, 0x9f, 0xfb, 0x90, 0x65, 0x13, 0xd5, 0xe5, 0xe5, 0x7d, 0xe1, 0x14, 0xe0,
+	0xe2, 0x66, 0xb0, 0x79, 0x85, 0x81, 0xd6, 0x51, 0xcd, 0x4a, 0x41, 0x1c, 0x42, 0x71, 0x17, 0x0b,
+	0x1d, 0xe7, 0x87, 0xd3, 0x02, 0x33, 0x63, 0x44, 0xc6, 0x8f, 0x03, 0xa8, 0xcf, 0xb6, 0x52, 0xb4,
+	0x85, 0x92, 0x93, 0x55, 0xd4, 0xac, 0xa7, 0xd9, 0x02, 0xf4, 0x25, 0x06, 0xfa, 0x3c, 0xda, 0xe2,
+	0xa0, 0x91, 0x75, 0xaa, 0xfa, 0x6b, 0x67, 0xd6, 0xa9, 0xea, 0xa6, 0x9d, 0xa1, 0xef, 0x32, 0x77,
+	0x24, 0xdb, 0x2f, 0x69, 0x77, 0x6c, 0x26, 0x94, 0xcc, 0x75, 0x69, 0xb4, 0x9c, 0x34, 0x0f, 0xd5,
+	0x86, 0xfc, 0x2e, 0xa6, 0xcf, 0xff, 0x7a, 0xfc, 0x76, 0x94, 0xc6, 0x23, 0x9d, 0x25, 0xb0, 0x10,
+	0xc3, 0x5a, 0x45, 0x60, 0x75, 0xa6, 0xd6, 0x29, 0xbd, 0xd5, 0xce, 0x10, 0x66, 0xd9, 0x20, 0x7e,
+	0xce, 0xc7, 0xd9, 0x60, 0xae, 0xe3, 0xd1, 0x6a, 0x9d, 0x27, 0x12, 0xd8, 0xcf, 0x31, 0xec, 0x2b,
+	0xe8, 0x19, 0x2b, 0x16, 0x6a, 0x47, 0xe7, 0x11, 0x94, 0xa5, 0xfd, 0x54, 0xc9, 0xf9, 0x9b, 0xd8,
+	0x4c, 0x70, 0x3b, 0xd3, 0x79, 0x97, 0x97, 0x2c, 0x29, 0x7a, 0x97, 0xba, 0x44, 0x12, 0xf1, 0xbe,
+	0xde, 0x83, 0x5a, 0xaa, 0x31, 0x89, 0x94, 0x87, 0xcf, 0xef, 0x58, 0xb6, 0xe6, 0xbb, 0x86, 0xaf,
+	0x1a, 0x68, 0x0f, 0xd6, 0xd4, 0x70, 0xfe, 0xf4, 0xbe, 0x18, 0xe8, 0x9c, 0x1e, 0xc4, 0xab, 0x46,
+	0xbb, 0xf9, 0xc1, 0xd3, 0xcd, 0x4b, 0x7f, 0x79, 0xba, 0x79, 0xe9, 0xef, 0x4f, 0x37, 0x8d, 0xf7,
+	0x3f, 0xda, 0xbc, 0xf4, 0xc7, 0x8f, 0x36, 0x8d, 0x0f, 0x3e, 0xda, 0x34, 0x7a, 0xe2, 0x9f, 0x38,
+	0xfe, 0x3d, 0x00, 0x1d, 0xc2, 0x46, 0x1e, 0xef, 0x21, 0x00, 0x00,
 }
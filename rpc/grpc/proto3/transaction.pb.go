@@ -41,7 +41,7 @@ func (m *Empty2) Reset()         { *m = Empty2{} }
 func (m *Empty2) String() string { return proto.CompactTextString(m) }
 func (*Empty2) ProtoMessage()    {}
 func (*Empty2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_89a98477ac501b03, []int{0}
+	return fileDescriptor_transaction_dd9a43ea29f3ecc7, []int{0}
 }
 func (m *Empty2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -85,7 +85,7 @@ func (m *TransactRequest) Reset()         { *m = TransactRequest{} }
 func (m *TransactRequest) String() string { return proto.CompactTextString(m) }
 func (*TransactRequest) ProtoMessage()    {}
 func (*TransactRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_89a98477ac501b03, []int{1}
+	return fileDescriptor_transaction_dd9a43ea29f3ecc7, []int{1}
 }
 func (m *TransactRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -119,7 +119,7 @@ func (*TransactRequest) XXX_MessageName() string {
 }
 
 type ReceiptResponse struct {
-	TxReceipt            *github_com_gallactic_gallactic_txs.Receipt `protobuf:"bytes,1,opt,name=TxReceipt,proto3,customtype=github.com/gallactic/gallactic/txs.Receipt" json:"TxReceipt,omitempty"`
+	TxReceipt            *github_com_gallactic_gallactic_txs.Receipt `protobuf:"bytes,1,opt,name=TxReceipt,customtype=github.com/gallactic/gallactic/txs.Receipt" json:"TxReceipt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
 	XXX_unrecognized     []byte                                      `json:"-"`
 	XXX_sizecache        int32                                       `json:"-"`
@@ -129,7 +129,7 @@ func (m *ReceiptResponse) Reset()         { *m = ReceiptResponse{} }
 func (m *ReceiptResponse) String() string { return proto.CompactTextString(m) }
 func (*ReceiptResponse) ProtoMessage()    {}
 func (*ReceiptResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_89a98477ac501b03, []int{2}
+	return fileDescriptor_transaction_dd9a43ea29f3ecc7, []int{2}
 }
 func (m *ReceiptResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -162,6 +162,67 @@ func (*ReceiptResponse) XXX_MessageName() string {
 	return "proto3.ReceiptResponse"
 }
 
+type CommitResponse struct {
+	CheckTx              *github_com_gallactic_gallactic_txs.Receipt `protobuf:"bytes,1,opt,name=CheckTx,customtype=github.com/gallactic/gallactic/txs.Receipt" json:"CheckTx,omitempty"`
+	DeliverTx            *github_com_gallactic_gallactic_txs.Receipt `protobuf:"bytes,2,opt,name=DeliverTx,customtype=github.com/gallactic/gallactic/txs.Receipt" json:"DeliverTx,omitempty"`
+	Height               int64                                       `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
+	BlockHash            []byte                                      `protobuf:"bytes,4,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_unrecognized     []byte                                      `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
+}
+
+func (m *CommitResponse) Reset()         { *m = CommitResponse{} }
+func (m *CommitResponse) String() string { return proto.CompactTextString(m) }
+func (*CommitResponse) ProtoMessage()    {}
+func (*CommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_dd9a43ea29f3ecc7, []int{3}
+}
+func (m *CommitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *CommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitResponse.Merge(dst, src)
+}
+func (m *CommitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitResponse proto.InternalMessageInfo
+
+func (m *CommitResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CommitResponse) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (*CommitResponse) XXX_MessageName() string {
+	return "proto3.CommitResponse"
+}
+
 type UnconfirmedTxsRequest struct {
 	MaxTxs               int32    `protobuf:"varint,1,opt,name=maxTxs,proto3" json:"maxTxs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -173,7 +234,7 @@ func (m *UnconfirmedTxsRequest) Reset()         { *m = UnconfirmedTxsRequest{} }
 func (m *UnconfirmedTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*UnconfirmedTxsRequest) ProtoMessage()    {}
 func (*UnconfirmedTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_89a98477ac501b03, []int{3}
+	return fileDescriptor_transaction_dd9a43ea29f3ecc7, []int{4}
 }
 func (m *UnconfirmedTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -225,7 +286,7 @@ func (m *UnconfirmTxsResponse) Reset()         { *m = UnconfirmTxsResponse{} }
 func (m *UnconfirmTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*UnconfirmTxsResponse) ProtoMessage()    {}
 func (*UnconfirmTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_89a98477ac501b03, []int{4}
+	return fileDescriptor_transaction_dd9a43ea29f3ecc7, []int{5}
 }
 func (m *UnconfirmTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -271,6 +332,8 @@ func init() {
 	golang_proto.RegisterType((*TransactRequest)(nil), "proto3.TransactRequest")
 	proto.RegisterType((*ReceiptResponse)(nil), "proto3.ReceiptResponse")
 	golang_proto.RegisterType((*ReceiptResponse)(nil), "proto3.ReceiptResponse")
+	proto.RegisterType((*CommitResponse)(nil), "proto3.CommitResponse")
+	golang_proto.RegisterType((*CommitResponse)(nil), "proto3.CommitResponse")
 	proto.RegisterType((*UnconfirmedTxsRequest)(nil), "proto3.UnconfirmedTxsRequest")
 	golang_proto.RegisterType((*UnconfirmedTxsRequest)(nil), "proto3.UnconfirmedTxsRequest")
 	proto.RegisterType((*UnconfirmTxsResponse)(nil), "proto3.UnconfirmTxsResponse")
@@ -292,6 +355,10 @@ type TransactionClient interface {
 	BroadcastTxSync(ctx context.Context, in *TransactRequest, opts ...grpc.CallOption) (*ReceiptResponse, error)
 	GetUnconfirmedTxs(ctx context.Context, in *Empty2, opts ...grpc.CallOption) (*UnconfirmTxsResponse, error)
 	BroadcastTxAsync(ctx context.Context, in *TransactRequest, opts ...grpc.CallOption) (*ReceiptResponse, error)
+	// BroadcastTxCommit returns once the block which includes the transaction is committed
+	BroadcastTxCommit(ctx context.Context, in *TransactRequest, opts ...grpc.CallOption) (*CommitResponse, error)
+	// BroadcastTxAuto sets the sequence of the unsigned transaction and signs it with a node-managed key
+	BroadcastTxAuto(ctx context.Context, in *TransactRequest, opts ...grpc.CallOption) (*ReceiptResponse, error)
 }
 
 type transactionClient struct {
@@ -329,11 +396,33 @@ func (c *transactionClient) BroadcastTxAsync(ctx context.Context, in *TransactRe
 	return out, nil
 }
 
+func (c *transactionClient) BroadcastTxCommit(ctx context.Context, in *TransactRequest, opts ...grpc.CallOption) (*CommitResponse, error) {
+	out := new(CommitResponse)
+	err := c.cc.Invoke(ctx, "/proto3.Transaction/BroadcastTxCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) BroadcastTxAuto(ctx context.Context, in *TransactRequest, opts ...grpc.CallOption) (*ReceiptResponse, error) {
+	out := new(ReceiptResponse)
+	err := c.cc.Invoke(ctx, "/proto3.Transaction/BroadcastTxAuto", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionServer is the server API for Transaction service.
 type TransactionServer interface {
 	BroadcastTxSync(context.Context, *TransactRequest) (*ReceiptResponse, error)
 	GetUnconfirmedTxs(context.Context, *Empty2) (*UnconfirmTxsResponse, error)
 	BroadcastTxAsync(context.Context, *TransactRequest) (*ReceiptResponse, error)
+	// BroadcastTxCommit returns once the block which includes the transaction is committed
+	BroadcastTxCommit(context.Context, *TransactRequest) (*CommitResponse, error)
+	// BroadcastTxAuto sets the sequence of the unsigned transaction and signs it with a node-managed key
+	BroadcastTxAuto(context.Context, *TransactRequest) (*ReceiptResponse, error)
 }
 
 func RegisterTransactionServer(s *grpc.Server, srv TransactionServer) {
@@ -394,6 +483,42 @@ func _Transaction_BroadcastTxAsync_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transaction_BroadcastTxCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).BroadcastTxCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto3.Transaction/BroadcastTxCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).BroadcastTxCommit(ctx, req.(*TransactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_BroadcastTxAuto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).BroadcastTxAuto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto3.Transaction/BroadcastTxAuto",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).BroadcastTxAuto(ctx, req.(*TransactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Transaction_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "proto3.Transaction",
 	HandlerType: (*TransactionServer)(nil),
@@ -410,6 +535,14 @@ var _Transaction_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BroadcastTxAsync",
 			Handler:    _Transaction_BroadcastTxAsync_Handler,
 		},
+		{
+			MethodName: "BroadcastTxCommit",
+			Handler:    _Transaction_BroadcastTxCommit_Handler,
+		},
+		{
+			MethodName: "BroadcastTxAuto",
+			Handler:    _Transaction_BroadcastTxAuto_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc/grpc/proto3/transaction.proto",
@@ -498,6 +631,58 @@ func (m *ReceiptResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *CommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.CheckTx != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.CheckTx.Size()))
+		n3, err := m.CheckTx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.DeliverTx != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.DeliverTx.Size()))
+		n4, err := m.DeliverTx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Height))
+	}
+	if len(m.BlockHash) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.BlockHash)))
+		i += copy(dAtA[i:], m.BlockHash)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *UnconfirmedTxsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -615,6 +800,33 @@ func (m *ReceiptResponse) Size() (n int) {
 	return n
 }
 
+func (m *CommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CheckTx != nil {
+		l = m.CheckTx.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.DeliverTx != nil {
+		l = m.DeliverTx.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTransaction(uint64(m.Height))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *UnconfirmedTxsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -882,6 +1094,171 @@ func (m *ReceiptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_gallactic_gallactic_txs.Receipt
+			m.CheckTx = &v
+			if err := m.CheckTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_gallactic_gallactic_txs.Receipt
+			m.DeliverTx = &v
+			if err := m.DeliverTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *UnconfirmedTxsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1159,39 +1536,45 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("rpc/grpc/proto3/transaction.proto", fileDescriptor_transaction_89a98477ac501b03)
+	proto.RegisterFile("rpc/grpc/proto3/transaction.proto", fileDescriptor_transaction_dd9a43ea29f3ecc7)
 }
 func init() {
-	golang_proto.RegisterFile("rpc/grpc/proto3/transaction.proto", fileDescriptor_transaction_89a98477ac501b03)
-}
-
-var fileDescriptor_transaction_89a98477ac501b03 = []byte{
-	// 428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x8e, 0xd3, 0x30,
-	0x10, 0x5e, 0x2f, 0xda, 0x0a, 0x26, 0x88, 0xb0, 0xd6, 0xc2, 0x56, 0xd1, 0x2a, 0x5d, 0x72, 0x5a,
-	0x81, 0x88, 0xa5, 0xf4, 0x09, 0xc8, 0xb2, 0xe2, 0xc2, 0x29, 0x84, 0x0b, 0x17, 0xe4, 0xba, 0x6e,
-	0x1a, 0x29, 0xb1, 0x43, 0xec, 0xa0, 0x54, 0xdc, 0x78, 0x05, 0x5e, 0x00, 0xde, 0x84, 0x63, 0x8f,
-	0x48, 0xdc, 0x7a, 0xa8, 0x50, 0xcb, 0x23, 0xf0, 0x00, 0xa8, 0xf9, 0x69, 0x43, 0x05, 0x08, 0xf6,
-	0x12, 0x79, 0x3c, 0x33, 0xdf, 0xf7, 0xcd, 0x97, 0x31, 0x3c, 0xc8, 0x33, 0x46, 0xa2, 0xcd, 0x27,
-	0xcb, 0xa5, 0x96, 0x43, 0xa2, 0x73, 0x2a, 0x14, 0x65, 0x3a, 0x96, 0xc2, 0xad, 0xae, 0x70, 0xaf,
-	0xce, 0x58, 0x8f, 0xa3, 0x58, 0x4f, 0x8b, 0x91, 0xcb, 0x64, 0x4a, 0x22, 0x19, 0xc9, 0xba, 0x63,
-	0x54, 0x4c, 0xaa, 0xa8, 0x0a, 0xaa, 0x53, 0xdd, 0x66, 0x9d, 0x45, 0x52, 0x46, 0x09, 0x27, 0x34,
-	0x8b, 0x09, 0x15, 0x42, 0x6a, 0xba, 0xc1, 0x54, 0x75, 0xd6, 0xb9, 0x09, 0xbd, 0xab, 0x34, 0xd3,
-	0x33, 0xcf, 0x79, 0x07, 0x66, 0xd8, 0x70, 0x06, 0xfc, 0x4d, 0xc1, 0x95, 0xc6, 0x53, 0x00, 0x5d,
-	0x5e, 0x89, 0xb7, 0x3c, 0x91, 0x19, 0xef, 0xa3, 0x73, 0x74, 0x61, 0x78, 0xa7, 0x75, 0xe3, 0xd0,
-	0xdd, 0x2b, 0xf6, 0xc9, 0x62, 0x39, 0x78, 0xd4, 0x95, 0x46, 0x93, 0x64, 0xa3, 0x9f, 0x75, 0x4e,
-	0xba, 0x54, 0x6e, 0x8b, 0x17, 0x74, 0xb0, 0x9d, 0xd7, 0x60, 0x06, 0x9c, 0xf1, 0x38, 0xd3, 0x01,
-	0x57, 0x99, 0x14, 0x8a, 0xe3, 0xe7, 0x70, 0x2b, 0x2c, 0x9b, 0xcb, 0x8a, 0xfb, 0xb6, 0xef, 0x2e,
-	0x96, 0x83, 0x87, 0xff, 0x40, 0xd1, 0x42, 0xed, 0x00, 0x1c, 0x02, 0xf7, 0x5e, 0x0a, 0x26, 0xc5,
-	0x24, 0xce, 0x53, 0x3e, 0x0e, 0x4b, 0xd5, 0xce, 0x78, 0x1f, 0x7a, 0x29, 0x2d, 0xc3, 0x52, 0x55,
-	0x1c, 0x47, 0x41, 0x13, 0x39, 0x9f, 0x10, 0x9c, 0x6c, 0x3b, 0xaa, 0xfa, 0x46, 0xd7, 0x09, 0x1c,
-	0x5d, 0xca, 0x42, 0xe8, 0xa6, 0xbe, 0x0e, 0xb0, 0x02, 0x63, 0x37, 0x8e, 0xea, 0x1f, 0x9e, 0xdf,
-	0xb8, 0x30, 0xbc, 0xb3, 0xd6, 0xab, 0xdf, 0x01, 0xf9, 0xc3, 0xf9, 0x72, 0x70, 0xf0, 0xbf, 0xa6,
-	0x75, 0x59, 0xbc, 0x1f, 0x08, 0x8c, 0x70, 0xb7, 0x27, 0xf8, 0x12, 0x4c, 0x3f, 0x97, 0x74, 0xcc,
-	0xa8, 0xd2, 0x61, 0xf9, 0x62, 0x26, 0x18, 0xfe, 0xd3, 0xef, 0xb2, 0xb6, 0x89, 0x7d, 0xdf, 0x5f,
-	0xc1, 0xf1, 0x33, 0xae, 0x7f, 0x35, 0x0b, 0xdf, 0x69, 0xab, 0xeb, 0x65, 0xb1, 0xfe, 0x3a, 0x99,
-	0x73, 0xfa, 0xfe, 0xeb, 0xf7, 0x0f, 0x87, 0xc7, 0xd8, 0x24, 0x7b, 0x30, 0x4f, 0xe1, 0x6e, 0x47,
-	0xe0, 0x13, 0x75, 0x2d, 0x85, 0x7e, 0x7f, 0xbe, 0xb2, 0xd1, 0x97, 0x95, 0x8d, 0xbe, 0xad, 0x6c,
-	0xf4, 0x71, 0x6d, 0x1f, 0x7c, 0x5e, 0xdb, 0x68, 0xbe, 0xb6, 0xd1, 0xa8, 0x79, 0x22, 0x3f, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x66, 0xbd, 0x90, 0x89, 0x4e, 0x03, 0x00, 0x00,
+	golang_proto.RegisterFile("rpc/grpc/proto3/transaction.proto", fileDescriptor_transaction_dd9a43ea29f3ecc7)
+}
+
+var fileDescriptor_transaction_dd9a43ea29f3ecc7 = []byte{
+	// 518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xee, 0xa6, 0x34, 0xd0, 0x09, 0x6a, 0xc8, 0xaa, 0xa4, 0x56, 0x14, 0x39, 0xc1, 0xa7, 0x08,
+	0x44, 0x2c, 0x25, 0x4f, 0x50, 0x27, 0x15, 0x39, 0x70, 0x32, 0xe6, 0xc2, 0x05, 0x6d, 0xb6, 0x5b,
+	0xc7, 0xaa, 0xbd, 0x6b, 0xbc, 0x9b, 0xca, 0x15, 0x37, 0x5e, 0x81, 0x17, 0x80, 0x37, 0xe1, 0x98,
+	0x23, 0x12, 0xb7, 0x1e, 0x22, 0x94, 0xf0, 0x1e, 0xa0, 0xf8, 0x27, 0x71, 0x23, 0x8a, 0x68, 0x2e,
+	0xd6, 0xce, 0xce, 0xcc, 0xf7, 0x7d, 0x3b, 0xfb, 0xad, 0xe1, 0x59, 0x14, 0x52, 0xd3, 0x5d, 0x7d,
+	0xc2, 0x48, 0x28, 0xd1, 0x37, 0x55, 0x44, 0xb8, 0x24, 0x54, 0x79, 0x82, 0x77, 0x93, 0x2d, 0x5c,
+	0x4e, 0x33, 0x8d, 0x97, 0xae, 0xa7, 0x26, 0xd3, 0x71, 0x97, 0x8a, 0xc0, 0x74, 0x85, 0x2b, 0xd2,
+	0x8e, 0xf1, 0xf4, 0x22, 0x89, 0x92, 0x20, 0x59, 0xa5, 0x6d, 0x8d, 0xa6, 0x2b, 0x84, 0xeb, 0x33,
+	0x93, 0x84, 0x9e, 0x49, 0x38, 0x17, 0x8a, 0xac, 0x30, 0x65, 0x9a, 0x35, 0x1e, 0x41, 0xf9, 0x2c,
+	0x08, 0xd5, 0x75, 0xcf, 0xf8, 0x08, 0x55, 0x27, 0xe3, 0xb4, 0xd9, 0x87, 0x29, 0x93, 0x0a, 0x4f,
+	0x00, 0x54, 0x7c, 0xc6, 0xaf, 0x98, 0x2f, 0x42, 0xa6, 0xa1, 0x36, 0xea, 0x54, 0x7a, 0x27, 0x69,
+	0x63, 0xbf, 0xbb, 0x55, 0x6c, 0x99, 0x37, 0xf3, 0xd6, 0x8b, 0xa2, 0x34, 0xe2, 0xfb, 0x2b, 0xfd,
+	0xb4, 0xb0, 0x52, 0xb1, 0xec, 0xe6, 0x78, 0x76, 0x01, 0xdb, 0x78, 0x0f, 0x55, 0x9b, 0x51, 0xe6,
+	0x85, 0xca, 0x66, 0x32, 0x14, 0x5c, 0x32, 0xfc, 0x1a, 0x0e, 0x9d, 0x38, 0xdb, 0x4c, 0xb8, 0x1f,
+	0x5b, 0xdd, 0x9b, 0x79, 0xeb, 0xf9, 0x7f, 0x50, 0xe4, 0x50, 0x1b, 0x00, 0x63, 0x8e, 0xe0, 0x68,
+	0x20, 0x82, 0xc0, 0xdb, 0x10, 0x8c, 0xe0, 0xe1, 0x60, 0xc2, 0xe8, 0xa5, 0x13, 0xef, 0x08, 0x9f,
+	0xb7, 0xaf, 0xa4, 0x0e, 0x99, 0xef, 0x5d, 0xb1, 0xc8, 0x89, 0xb5, 0xd2, 0x6e, 0x52, 0xd7, 0x00,
+	0xb8, 0x0e, 0xe5, 0x11, 0xf3, 0xdc, 0x89, 0xd2, 0xf6, 0xdb, 0xa8, 0xb3, 0x6f, 0x67, 0x11, 0x6e,
+	0xc2, 0xa1, 0xe5, 0x0b, 0x7a, 0x39, 0x22, 0x72, 0xa2, 0x3d, 0x58, 0xb1, 0xd8, 0x9b, 0x0d, 0xc3,
+	0x84, 0xa7, 0x6f, 0x39, 0x15, 0xfc, 0xc2, 0x8b, 0x02, 0x76, 0xee, 0xc4, 0x32, 0xbf, 0xc4, 0x3a,
+	0x94, 0x03, 0x12, 0x3b, 0xb1, 0x4c, 0x4e, 0x79, 0x60, 0x67, 0x91, 0xf1, 0x15, 0xc1, 0xf1, 0xba,
+	0x23, 0xa9, 0xcf, 0xe6, 0x72, 0x0c, 0x07, 0x03, 0x31, 0xe5, 0x2a, 0xab, 0x4f, 0x03, 0x2c, 0xa1,
+	0xb2, 0xb9, 0x2f, 0xa9, 0x95, 0xda, 0xfb, 0x9d, 0x4a, 0xaf, 0x99, 0x9b, 0xe1, 0x6f, 0x40, 0x56,
+	0x7f, 0x36, 0x6f, 0xed, 0xdd, 0xd7, 0x15, 0x45, 0x96, 0xde, 0xef, 0x12, 0x54, 0x9c, 0xcd, 0x43,
+	0xc0, 0x03, 0xa8, 0x5a, 0x91, 0x20, 0xe7, 0x94, 0x48, 0xe5, 0xc4, 0x6f, 0xae, 0x39, 0xc5, 0x77,
+	0xf9, 0xb1, 0xb1, 0x4e, 0x6c, 0x1b, 0xeb, 0x1d, 0xd4, 0x5e, 0x31, 0x75, 0x7b, 0x58, 0xf8, 0x28,
+	0xaf, 0x4e, 0x5f, 0x43, 0xe3, 0x9f, 0x27, 0x33, 0x4e, 0x3e, 0xfd, 0xf8, 0xf5, 0xb9, 0x54, 0xc3,
+	0x55, 0x73, 0x0b, 0x66, 0x08, 0x4f, 0x0a, 0x02, 0x4f, 0xe5, 0x6e, 0x0a, 0x87, 0x50, 0x2b, 0xa0,
+	0xa4, 0xb6, 0xbd, 0x1b, 0xa6, 0x9e, 0x27, 0xb6, 0xfc, 0x7d, 0x7b, 0x58, 0xa7, 0x53, 0x25, 0xee,
+	0x2f, 0xc5, 0xd2, 0x66, 0x0b, 0x1d, 0x7d, 0x5f, 0xe8, 0xe8, 0xe7, 0x42, 0x47, 0x5f, 0x96, 0xfa,
+	0xde, 0xb7, 0xa5, 0x8e, 0x66, 0x4b, 0x1d, 0x8d, 0xb3, 0xdf, 0xd1, 0x9f, 0x01, 0x00, 0xec, 0xbe,
+	0xd0, 0x69, 0xba, 0x04, 0x00, 0x00,
 }
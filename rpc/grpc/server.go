@@ -2,53 +2,188 @@ package grpc
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
 	"fmt"
 	"net"
 	"runtime/debug"
+	"strings"
+	"time"
 
-	log "github.com/inconshreveable/log15"
+	"github.com/gallactic/gallactic/core/logging"
+	"github.com/gallactic/gallactic/core/metrics"
+	"github.com/gallactic/gallactic/rpc/auth"
+	"github.com/gallactic/gallactic/rpc/grpc/config"
+	"github.com/gallactic/gallactic/rpc/limit"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
+var logger = logging.New(logging.ModuleRPC)
+
+// MethodLevels are the default access levels of the methods if the authentication is enabled.
+// The other methods are public.
+var MethodLevels = map[string]auth.Level{
+	"/proto3.Transaction/BroadcastTxSync":   auth.User,
+	"/proto3.Transaction/BroadcastTxAsync":  auth.User,
+	"/proto3.Transaction/BroadcastTxCommit": auth.User,
+	"/proto3.Transaction/BroadcastTxAuto":   auth.Admin,
+	"/proto3.BlockChain/GetConsensusState":  auth.Admin,
+	"/proto3.Network/GetNetworkInfo":        auth.Admin,
+	"/proto3.Network/GetPeers":              auth.Admin,
+}
+
 type Server struct {
 	*grpc.Server
+	tls          config.TLS
+	health       *health.Server
+	limiter      *limit.Limiter
+	gatewayToken string
+}
+
+// NewGRPCServer creates a gRPC server, which is secured by TLS if it is enabled.
+// The authenticator and the limiter may be nil.
+func NewGRPCServer(tlsConf config.TLS, authenticator *auth.Authenticator, limiter *limit.Limiter) (*Server, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+	s := &Server{
+		tls:          tlsConf,
+		health:       health.NewServer(),
+		limiter:      limiter,
+		gatewayToken: hex.EncodeToString(token),
+	}
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(s.unaryInterceptor(authenticator)),
+		grpc.StreamInterceptor(s.streamInterceptor(authenticator)),
+	}
+	if max := limiter.MaxRequestSize(); max > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(int(max)))
+	}
+	if tlsConf.Enabled {
+		serverTLS, err := serverTLSConfig(tlsConf)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(serverTLS)))
+	}
+	s.Server = grpc.NewServer(opts...)
+	// Not serving until the node has caught up
+	s.health.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(s.Server, s.health)
+	reflection.Register(s.Server)
+	return s, nil
 }
 
-func NewGRPCServer() *Server {
-	return &Server{
-		grpc.NewServer(
-			grpc.UnaryInterceptor(unaryInterceptor()),
-			grpc.StreamInterceptor(streamInterceptor()))}
+// clientIP returns the IP address of the caller. The gateway passes the IP address of its client with
+// the token of this server. Otherwise the caller is the peer, or the client of a trusted proxy.
+func (s *Server) clientIP(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if token := md[gatewayTokenKey]; len(token) == 1 &&
+		subtle.ConstantTimeCompare([]byte(token[0]), []byte(s.gatewayToken)) == 1 {
+		if ip := md[gatewayClientIPKey]; len(ip) == 1 {
+			return ip[0]
+		}
+	}
+	var addr string
+	if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+	}
+	return s.limiter.ClientIP(addr, strings.Join(md["x-forwarded-for"], ","))
+}
+
+// authorize checks the access of the caller to the method. The caller is authenticated by
+// the `x-api-key` or the `authorization: Bearer` metadata.
+func authorize(ctx context.Context, authenticator *auth.Authenticator, method string) error {
+	if !authenticator.Enabled() {
+		return nil
+	}
+	var apiKey, authorization string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md["x-api-key"]; len(v) > 0 {
+			apiKey = v[0]
+		}
+		if v := md["authorization"]; len(v) > 0 {
+			authorization = v[0]
+		}
+	}
+	token := auth.TokenFromHeaders(apiKey, authorization)
+	level, err := authenticator.Authenticate(token)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := authenticator.Authorize(method, level); err != nil {
+		if token == "" {
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return nil
 }
 
-func unaryInterceptor() grpc.UnaryServerInterceptor {
+func (s *Server) unaryInterceptor(authenticator *auth.Authenticator) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler) (resp interface{}, err error) {
 		defer func() {
 			if r := recover(); r != nil {
-				log.Error("panic in GRPC unary call",
+				logger.Error("panic in GRPC unary call",
 					"error", fmt.Sprintf("%v", r))
 
 				err = fmt.Errorf("panic in GRPC unary call %s: %v: %s", info.FullMethod, r, debug.Stack())
 			}
 		}()
-		log.Debug("GRPC unary call")
+		logger.Debug("GRPC unary call")
+		if err := authorize(ctx, authenticator, info.FullMethod); err != nil {
+			return nil, err
+		}
+		if err := s.limiter.Allow(s.clientIP(ctx), info.FullMethod); err != nil {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		release, err := s.limiter.Acquire()
+		if err != nil {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		defer release()
+		defer func(start time.Time) {
+			metrics.RPCRequest("grpc", info.FullMethod, time.Since(start))
+		}(time.Now())
 		return handler(ctx, req)
 	}
 }
 
-func streamInterceptor() grpc.StreamServerInterceptor {
+func (s *Server) streamInterceptor(authenticator *auth.Authenticator) grpc.StreamServerInterceptor {
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
 		handler grpc.StreamHandler) (err error) {
 		defer func() {
 			if r := recover(); r != nil {
-				log.Error("panic in GRPC stream",
+				logger.Error("panic in GRPC stream",
 					"error", fmt.Sprintf("%v", r))
 
 				err = fmt.Errorf("panic in GRPC stream %s: %v: %s", info.FullMethod, r, debug.Stack())
 			}
 		}()
-		log.Debug("GRPC stream call")
+		logger.Debug("GRPC stream call")
+		if err := authorize(ss.Context(), authenticator, info.FullMethod); err != nil {
+			return err
+		}
+		if err := s.limiter.Allow(s.clientIP(ss.Context()), info.FullMethod); err != nil {
+			return status.Error(codes.ResourceExhausted, err.Error())
+		}
+		// The slot is held while the stream is open
+		release, err := s.limiter.AcquireStream()
+		if err != nil {
+			return status.Error(codes.ResourceExhausted, err.Error())
+		}
+		defer release()
 		return handler(srv, ss)
 	}
 }
@@ -65,5 +200,7 @@ func (s *Server) Start(addr string) error {
 }
 
 func (s *Server) Stop() {
+	// Report not serving to the clients which are watching the health
+	s.health.Shutdown()
 	s.Server.Stop()
 }
@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/gallactic/gallactic/rpc/grpc/config"
+	"github.com/gallactic/gallactic/rpc/limit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestClientIP(t *testing.T) {
+	limiter, err := limit.NewLimiter(&limit.Config{TrustedProxies: []string{"10.0.0.1"}})
+	require.NoError(t, err)
+	s, err := NewGRPCServer(config.TLS{}, nil, limiter)
+	require.NoError(t, err)
+
+	call := func(peerIP string, kv ...string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(peerIP), Port: 1234}})
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(kv...))
+	}
+
+	assert.Equal(t, "1.1.1.1", s.clientIP(call("1.1.1.1")))
+	// The forwarded headers and the gateway metadata without the token are ignored
+	assert.Equal(t, "127.0.0.1", s.clientIP(call("127.0.0.1", "x-forwarded-for", "2.2.2.2")))
+	assert.Equal(t, "127.0.0.1", s.clientIP(call("127.0.0.1", gatewayTokenKey, "guess", gatewayClientIPKey, "2.2.2.2")))
+	// The trusted proxy and the gateway set the client
+	assert.Equal(t, "2.2.2.2", s.clientIP(call("10.0.0.1", "x-forwarded-for", "2.2.2.2")))
+	assert.Equal(t, "2.2.2.2", s.clientIP(call("127.0.0.1", gatewayTokenKey, s.gatewayToken, gatewayClientIPKey, "2.2.2.2")))
+}
+
+func TestHeaderMatcher(t *testing.T) {
+	key, ok := headerMatcher("x-api-key")
+	assert.True(t, ok)
+	assert.Equal(t, "x-api-key", key)
+	_, ok = headerMatcher("Grpc-Metadata-X-Gallactic-Client-Ip")
+	assert.False(t, ok)
+	_, ok = headerMatcher("grpc-metadata-x-gallactic-gateway")
+	assert.False(t, ok)
+}
+
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testStream) Context() context.Context { return s.ctx }
+
+func TestStreamLimit(t *testing.T) {
+	limiter, err := limit.NewLimiter(&limit.Config{MaxStreams: 1})
+	require.NoError(t, err)
+	s, err := NewGRPCServer(config.TLS{}, nil, limiter)
+	require.NoError(t, err)
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("1.1.1.1"), Port: 1234}})
+	stream := &testStream{ctx: ctx}
+	info := &grpc.StreamServerInfo{FullMethod: "/proto3.BlockChain/SubscribeBlocks"}
+	interceptor := s.streamInterceptor(nil)
+
+	opened, closing, closed := make(chan struct{}), make(chan struct{}), make(chan error)
+	go func() {
+		closed <- interceptor(nil, stream, info, func(interface{}, grpc.ServerStream) error {
+			close(opened)
+			<-closing
+			return nil
+		})
+	}()
+	<-opened
+
+	// The open stream holds the only slot
+	handler := func(interface{}, grpc.ServerStream) error { return nil }
+	err = interceptor(nil, stream, info, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	close(closing)
+	require.NoError(t, <-closed)
+	assert.NoError(t, interceptor(nil, stream, info, handler))
+}
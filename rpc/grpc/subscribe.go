@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/gallactic/gallactic/core/events"
+)
+
+// followBlocks calls send for the blocks from fromHeight up to the last block, then for every
+// new block until the context is done. Zero fromHeight starts from the next block.
+func followBlocks(ctx context.Context, eventBus events.EventBus, lastHeight func() uint64,
+	fromHeight uint64, send func(height int64) error) error {
+
+	// Subscribe before replaying, so no block is missed in between
+	// The blocks are loaded by height, so the events are only a wake-up signal
+	subID := events.GenSubID()
+	newBlock := make(chan struct{}, 1)
+	if err := events.SubscribeFunc(ctx, eventBus, subID, events.QueryForNewBlock(), func(msg interface{}) {
+		select {
+		case newBlock <- struct{}{}:
+		default:
+		}
+	}); err != nil {
+		return err
+	}
+	defer eventBus.UnsubscribeAll(context.Background(), subID)
+
+	next := fromHeight
+	if next == 0 {
+		next = lastHeight() + 1
+	}
+	for {
+		for ; next <= lastHeight(); next++ {
+			if err := send(int64(next)); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-newBlock:
+		}
+	}
+}
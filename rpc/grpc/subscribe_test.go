@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gallactic/gallactic/core/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// commitBlocks increases the last height and publishes the block events
+func commitBlocks(t *testing.T, eventBus events.EventBus, last *uint64, n int) {
+	for i := 0; i < n; i++ {
+		height := atomic.AddUint64(last, 1)
+		require.NoError(t, eventBus.Publish(&events.Block{Height: int64(height)}, events.TagsForBlock()))
+	}
+}
+
+func TestFollowBlocks(t *testing.T) {
+	eventBus := events.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	defer eventBus.Stop()
+
+	var last uint64 = 5
+	lastHeight := func() uint64 { return atomic.LoadUint64(&last) }
+
+	follow := func(fromHeight uint64, count int) <-chan []int64 {
+		ctx, cancel := context.WithCancel(context.Background())
+		heights := make(chan int64, 100)
+		result := make(chan []int64, 1)
+		go func() {
+			err := followBlocks(ctx, eventBus, lastHeight, fromHeight, func(height int64) error {
+				heights <- height
+				return nil
+			})
+			assert.Equal(t, context.Canceled, err)
+		}()
+		go func() {
+			defer cancel()
+			var list []int64
+			for len(list) < count {
+				select {
+				case h := <-heights:
+					list = append(list, h)
+				case <-time.After(2 * time.Second):
+					result <- list
+					return
+				}
+			}
+			// No more blocks are expected
+			select {
+			case h := <-heights:
+				list = append(list, h)
+			case <-time.After(100 * time.Millisecond):
+			}
+			result <- list
+		}()
+		return result
+	}
+
+	// Replays the missed blocks, then follows the new blocks
+	replay := follow(3, 6)
+	// Only the new blocks
+	live := follow(0, 3)
+	time.Sleep(100 * time.Millisecond)
+	commitBlocks(t, eventBus, &last, 3)
+
+	assert.Equal(t, []int64{3, 4, 5, 6, 7, 8}, <-replay)
+	assert.Equal(t, []int64{6, 7, 8}, <-live)
+}
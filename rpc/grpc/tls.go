@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+
+	"github.com/gallactic/gallactic/rpc/grpc/config"
+)
+
+// serverTLSConfig loads the certificate of the server. With a client CA, the clients
+// should present a certificate signed by it.
+func serverTLSConfig(conf config.TLS) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to load the server certificate: %v", err)
+	}
+	tlsConf := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if conf.ClientCAFile != "" {
+		pool, err := loadCertPool(conf.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConf.ClientCAs = pool
+		tlsConf.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConf, nil
+}
+
+// gatewayTLSConfig loads the credentials of the gateway to dial the gRPC server at the address
+func gatewayTLSConfig(conf config.TLS, addr string) (*tls.Config, error) {
+	caFile := conf.CAFile
+	if caFile == "" {
+		caFile = conf.CertFile
+	}
+	pool, err := loadCertPool(caFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsConf := &tls.Config{
+		RootCAs:    pool,
+		ServerName: serverName(addr),
+		MinVersion: tls.VersionTLS12,
+	}
+	if conf.ClientCAFile != "" {
+		certFile, keyFile := conf.GatewayCertFile, conf.GatewayKeyFile
+		if certFile == "" {
+			certFile, keyFile = conf.CertFile, conf.KeyFile
+		}
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to load the gateway certificate: %v", err)
+		}
+		tlsConf.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConf, nil
+}
+
+// serverName returns the host of the address, or localhost if it listens on all the interfaces
+func serverName(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		return "localhost"
+	}
+	return host
+}
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to load the certificate authority: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("No certificate found in %s", file)
+	}
+	return pool, nil
+}
@@ -0,0 +1,132 @@
+package grpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gallactic/gallactic/rpc/grpc/config"
+	pb "github.com/gallactic/gallactic/rpc/grpc/proto3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// writeCert creates a certificate signed by the parent, or a self-signed CA if the parent is nil
+func writeCert(t *testing.T, dir, name string, parent *testCert, usage x509.ExtKeyUsage) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+	} else {
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{usage}
+		tmpl.DNSNames = []string{"localhost"}
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name+".crt"),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name+".key"),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return &testCert{cert: cert, key: key}
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "grpc-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := writeCert(t, dir, "ca", nil, 0)
+	writeCert(t, dir, "server", ca, x509.ExtKeyUsageServerAuth)
+	writeCert(t, dir, "gateway", ca, x509.ExtKeyUsageClientAuth)
+	path := func(name string) string { return filepath.Join(dir, name) }
+
+	tlsConf := config.TLS{
+		Enabled:         true,
+		CertFile:        path("server.crt"),
+		KeyFile:         path("server.key"),
+		ClientCAFile:    path("ca.crt"),
+		CAFile:          path("ca.crt"),
+		GatewayCertFile: path("gateway.crt"),
+		GatewayKeyFile:  path("gateway.key"),
+	}
+	s, err := NewGRPCServer(tlsConf, nil, nil)
+	require.NoError(t, err)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go s.Serve(lis)
+	defer s.Stop()
+	_, port, _ := net.SplitHostPort(lis.Addr().String())
+
+	call := func(tlsConf *tls.Config) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		conn, err := grpc.DialContext(ctx, "127.0.0.1:"+port, grpc.WithTransportCredentials(credentials.NewTLS(tlsConf)))
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		return conn.Invoke(ctx, "/proto3.Network/GetPeers", &pb.Empty1{}, &pb.PeerResponse{}, grpc.FailFast(true))
+	}
+
+	// The gateway credentials pass the handshake. No service is registered.
+	gatewayTLS, err := gatewayTLSConfig(tlsConf, "0.0.0.0:"+port)
+	require.NoError(t, err)
+	assert.Equal(t, "localhost", gatewayTLS.ServerName)
+	err = call(gatewayTLS)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	// Without a client certificate
+	noCert := &tls.Config{RootCAs: gatewayTLS.RootCAs, ServerName: "localhost"}
+	err = call(noCert)
+	assert.Error(t, err)
+	assert.NotEqual(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestServerName(t *testing.T) {
+	assert.Equal(t, "localhost", serverName("0.0.0.0:50051"))
+	assert.Equal(t, "localhost", serverName(":50051"))
+	assert.Equal(t, "localhost", serverName("[::]:50051"))
+	assert.Equal(t, "node.example.com", serverName("node.example.com:50051"))
+	assert.Equal(t, "127.0.0.1", serverName("127.0.0.1:50051"))
+}
+
+func TestInvalidTLSConfig(t *testing.T) {
+	_, err := NewGRPCServer(config.TLS{Enabled: true, CertFile: "missing.crt", KeyFile: "missing.key"}, nil, nil)
+	assert.Error(t, err)
+}
@@ -4,23 +4,29 @@ import (
 	"context"
 	"github.com/gallactic/gallactic/core/consensus/tendermint/query"
 	"github.com/gallactic/gallactic/core/execution"
+	e "github.com/gallactic/gallactic/errors"
 	pb "github.com/gallactic/gallactic/rpc/grpc/proto3"
 	"github.com/gallactic/gallactic/txs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type transcatorService struct {
-	ctx        context.Context
-	nodeview   *query.NodeView
-	transactor *execution.Transactor
+	ctx         context.Context
+	nodeview    *query.NodeView
+	transactor  *execution.Transactor
+	enableAdmin bool
 }
 
 var _ pb.TransactionServer = &transcatorService{}
 
-func NewTransactorService(con context.Context, transaction *execution.Transactor, nview *query.NodeView) *transcatorService {
+// NewTransactorService creates the transaction service. BroadcastTxAuto is available if enableAdmin is set.
+func NewTransactorService(con context.Context, transaction *execution.Transactor, nview *query.NodeView, enableAdmin bool) *transcatorService {
 	return &transcatorService{
-		transactor: transaction,
-		nodeview:   nview,
-		ctx:        con,
+		transactor:  transaction,
+		nodeview:    nview,
+		ctx:         con,
+		enableAdmin: enableAdmin,
 	}
 }
 
@@ -28,7 +34,7 @@ func NewTransactorService(con context.Context, transaction *execution.Transactor
 func (tx *transcatorService) BroadcastTxSync(ctx context.Context, txReq *pb.TransactRequest) (*pb.ReceiptResponse, error) {
 	receipt, err := tx.transactor.BroadcastTxSync(txReq.TxEnvelope)
 	if err != nil {
-		return nil, err
+		return nil, broadcastError(err)
 	}
 
 	return &pb.ReceiptResponse{
@@ -57,9 +63,56 @@ func (tx *transcatorService) GetUnconfirmedTxs(ctx context.Context, unconfirmreq
 func (tx *transcatorService) BroadcastTxAsync(ctx context.Context, txReq *pb.TransactRequest) (*pb.ReceiptResponse, error) {
 	receipt, err := tx.transactor.BroadcastTxAsync(txReq.TxEnvelope)
 	if err != nil {
+		return nil, broadcastError(err)
+	}
+
+	return &pb.ReceiptResponse{
+		TxReceipt: receipt,
+	}, nil
+}
+
+// broadcastError returns FailedPrecondition if the fee of the transaction is lower than the node's minimum fee
+func broadcastError(err error) error {
+	if e.Code(err) == e.ErrInsufficientFee {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
+
+// BroadcastTxCommit returns FailedPrecondition if the mempool rejects the transaction or it expires,
+// and DeadlineExceeded if it is not committed in the commit timeout
+func (tx *transcatorService) BroadcastTxCommit(ctx context.Context, txReq *pb.TransactRequest) (*pb.CommitResponse, error) {
+	result, err := tx.transactor.BroadcastTxCommit(txReq.TxEnvelope)
+	if err != nil {
+		switch e.Code(err) {
+		case e.ErrTxRejected, e.ErrTxExpired, e.ErrInsufficientFee:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case e.ErrTimeOut:
+			return nil, status.Error(codes.DeadlineExceeded, err.Error())
+		}
 		return nil, err
 	}
 
+	return &pb.CommitResponse{
+		CheckTx:   result.CheckTx,
+		DeliverTx: result.DeliverTx,
+		Height:    result.Height,
+		BlockHash: result.BlockHash,
+	}, nil
+}
+
+func (tx *transcatorService) BroadcastTxAuto(ctx context.Context, txReq *pb.TransactRequest) (*pb.ReceiptResponse, error) {
+	if !tx.enableAdmin {
+		return nil, status.Error(codes.Unimplemented, "admin methods are disabled")
+	}
+	if txReq.TxEnvelope == nil || txReq.TxEnvelope.Tx == nil {
+		return nil, status.Error(codes.InvalidArgument, "transaction is empty")
+	}
+	receipt, err := tx.transactor.BroadcastTxAuto(txReq.TxEnvelope.Tx, txReq.TxEnvelope.ValidUntilHeight)
+	if err != nil {
+		return nil, broadcastError(err)
+	}
+
 	return &pb.ReceiptResponse{
 		TxReceipt: receipt,
 	}, nil
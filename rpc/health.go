@@ -0,0 +1,96 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	rpcConfig "github.com/gallactic/gallactic/rpc/config"
+	"github.com/gin-gonic/gin"
+)
+
+// Server used to serve the health and the readiness of the node over HTTP. Implements server.Server
+type HealthServer struct {
+	service *Service
+	config  rpcConfig.Health
+	running bool
+}
+
+// Create a new HealthServer
+func NewHealthServer(service *Service) *HealthServer {
+	return &HealthServer{service: service}
+}
+
+// Start adds the health and the readiness paths to the router.
+func (hs *HealthServer) Start(config *rpcConfig.ServerConfig, router *gin.Engine) {
+	if !config.Health.Enable {
+		return
+	}
+	hs.config = config.Health
+	router.GET(config.Health.HealthEndpoint, hs.handleHealth)
+	router.GET(config.Health.ReadyEndpoint, hs.handleReady)
+	hs.running = true
+}
+
+// Is the server currently running?
+func (hs *HealthServer) Running() bool {
+	return hs.running
+}
+
+// Shut the server down. Does nothing.
+func (hs *HealthServer) Shutdown(ctx context.Context) error {
+	hs.running = false
+	return nil
+}
+
+func (hs *HealthServer) health() (*HealthOutput, error) {
+	out, err := hs.service.Health()
+	if err != nil {
+		return nil, err
+	}
+	out.Reasons = readiness(out, hs.config)
+	out.Ready = len(out.Reasons) == 0
+	return out, nil
+}
+
+// handleHealth replies OK while the node is running
+func (hs *HealthServer) handleHealth(c *gin.Context) {
+	out, err := hs.health()
+	if err != nil {
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// handleReady replies OK if the node is ready to serve, otherwise `503 Service Unavailable`
+func (hs *HealthServer) handleReady(c *gin.Context) {
+	out, err := hs.health()
+	if err != nil {
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	status := http.StatusOK
+	if !out.Ready {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, out)
+}
+
+// readiness returns the reasons which the node is not ready for
+func readiness(out *HealthOutput, config rpcConfig.Health) []string {
+	var reasons []string
+	if out.CatchingUp {
+		reasons = append(reasons, "Node is catching up")
+	}
+	if out.Peers < config.MinPeers {
+		reasons = append(reasons, fmt.Sprintf("Node has %d peers, at least %d peers are required", out.Peers, config.MinPeers))
+	}
+	if config.MaxBlockAge > 0 && out.LatestBlockAge > float64(config.MaxBlockAge) {
+		reasons = append(reasons, fmt.Sprintf("Last block is %.0f seconds old, at most %d seconds is allowed", out.LatestBlockAge, config.MaxBlockAge))
+	}
+	if config.RequireValidator && !out.IsValidator {
+		reasons = append(reasons, "Node is not in the validator set")
+	}
+	return reasons
+}
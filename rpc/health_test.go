@@ -0,0 +1,26 @@
+package rpc
+
+import (
+	"testing"
+
+	rpcConfig "github.com/gallactic/gallactic/rpc/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadiness(t *testing.T) {
+	config := rpcConfig.Health{MinPeers: 2, MaxBlockAge: 60, RequireValidator: true}
+
+	out := &HealthOutput{Peers: 3, LatestBlockAge: 5, IsValidator: true}
+	assert.Empty(t, readiness(out, config))
+
+	out = &HealthOutput{CatchingUp: true, Peers: 1, LatestBlockAge: 120, IsValidator: false}
+	assert.Equal(t, []string{
+		"Node is catching up",
+		"Node has 1 peers, at least 2 peers are required",
+		"Last block is 120 seconds old, at most 60 seconds is allowed",
+		"Node is not in the validator set",
+	}, readiness(out, config))
+
+	// No limits
+	assert.Equal(t, []string{"Node is catching up"}, readiness(out, rpcConfig.Health{}))
+}
@@ -10,8 +10,17 @@ type (
 		Address crypto.Address `json:"address"`
 	}
 
+	// PageInput selects a page of a listing. Cursor is the nextCursor of the
+	// previous page and order is "asc" or "desc".
+	PageInput struct {
+		Cursor string `json:"cursor,omitempty"`
+		Limit  int    `json:"limit,omitempty"`
+		Order  string `json:"order,omitempty"`
+	}
+
 	FilterListInput struct {
 		Filters []*FilterData `json:"filters"`
+		PageInput
 	}
 
 	StorageAtInput struct {
@@ -26,12 +35,44 @@ type (
 	BlocksInput struct {
 		MinHeight uint64 `json:"minHeight"`
 		MaxHeight uint64 `json:"maxHeight"`
+		PageInput
+	}
+
+	// ValidatorsInput lists the validators by status: "active" (in the validator set),
+	// "inactive" (bonded but not in the set) or all if the status is not set
+	ValidatorsInput struct {
+		Status string `json:"status,omitempty"`
+		PageInput
 	}
 
 	PeersInput struct {
 		Address crypto.Address `json:"address"`
 	}
 
+	AccountTxsInput struct {
+		Address    crypto.Address `json:"address"`
+		FromHeight uint64         `json:"fromHeight"`
+		ToHeight   uint64         `json:"toHeight"`
+		PageInput
+	}
+
+	// SubscribeInput subscribes to the new blocks (event: "newBlock") or to the
+	// receipts of the transactions by hash or address (event: "tx")
+	SubscribeInput struct {
+		Event   string          `json:"event"`
+		Hash    binary.HexBytes `json:"hash,omitempty"`
+		Address *crypto.Address `json:"address,omitempty"`
+	}
+
+	UnsubscribeInput struct {
+		SubscriptionID string `json:"subscriptionId"`
+	}
+
+	LogLevelInput struct {
+		Module string `json:"module"`
+		Level  string `json:"level"`
+	}
+
 	BlockInfoInput struct {
 		BlockWithin string `json:"blockWithin"`
 	}
@@ -15,15 +15,24 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"net/http"
+	"time"
 
+	"github.com/gallactic/gallactic/core/logging"
+	"github.com/gallactic/gallactic/core/metrics"
+	"github.com/gallactic/gallactic/rpc/auth"
 	rpcConfig "github.com/gallactic/gallactic/rpc/config"
+	"github.com/gallactic/gallactic/rpc/limit"
 	"github.com/gin-gonic/gin"
-	log "github.com/inconshreveable/log15"
 )
 
+var logger = logging.New(logging.ModuleRPC)
+
 // Server used to handle JSON-RPC 2.0 requests. Implements server.Server
 type JsonRpcServer struct {
 	service HttpService
@@ -61,82 +70,156 @@ func (jrs *JsonRpcServer) handleFunc(c *gin.Context) {
 	jrs.service.Process(r, w)
 }
 
+// Maximum number of the requests in a batch
+const MaxBatchSize = 100
+
 type JSONService struct {
 	codec           Codec
 	service         *Service
+	authenticator   *auth.Authenticator
+	limiter         *limit.Limiter
 	defaultHandlers map[string]RequestHandlerFunc
 }
 
-// Create a new JSON-RPC 2.0 service for gallactic
-func NewJSONService(codec Codec, service *Service) HttpService {
+// Create a new JSON-RPC 2.0 service for gallactic. Admin methods are available if enableAdmin is set.
+// A nil authenticator or limiter allows all the requests.
+func NewJSONService(codec Codec, service *Service, enableAdmin bool,
+	authenticator *auth.Authenticator, limiter *limit.Limiter) *JSONService {
 
 	httpService := &JSONService{
-		codec:   codec,
-		service: service,
+		codec:         codec,
+		service:       service,
+		authenticator: authenticator,
+		limiter:       limiter,
 	}
 
-	dhMap := GetMethods(codec, service)
+	dhMap := GetMethods(codec, service, enableAdmin, limiter)
 	httpService.defaultHandlers = dhMap
 	return httpService
 }
 
 // Process a request.
 func (js *JSONService) Process(r *http.Request, w http.ResponseWriter) {
+	body := r.Body
+	if max := js.limiter.MaxRequestSize(); max > 0 {
+		if r.ContentLength > max {
+			js.write(NewRPCErrorResponse("", RPCErrorLimitExceeded, "Request is too large"), w)
+			return
+		}
+		body = http.MaxBytesReader(w, body, max)
+	}
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		js.write(NewRPCErrorResponse("", RPCErrorParseError, "Failed to read request: "+err.Error()), w)
+		return
+	}
+
+	response := js.ProcessMessage(r.Context(), data, w)
+	if response == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	js.write(response, w)
+}
+
+// ProcessMessage handles a single request or a batch of requests and returns the response.
+// The notifications of a batch have no response, and a batch of notifications returns nil.
+// The access level and the limits of the caller in the context are checked for each request.
+// The requester is passed to the handlers, e.g. the WebSocket session.
+func (js *JSONService) ProcessMessage(ctx context.Context, data []byte, requester interface{}) interface{} {
+	trimmed := bytes.TrimLeft(data, " \t\r\n")
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		return js.handle(ctx, data, requester)
+	}
+
+	var batch []json.RawMessage
+	if err := json.Unmarshal(data, &batch); err != nil {
+		return NewRPCErrorResponse("", RPCErrorParseError, "Failed to parse request: "+err.Error())
+	}
+	if len(batch) == 0 {
+		return NewRPCErrorResponse("", RPCErrorInvalidRequest, "Empty batch")
+	}
+	if len(batch) > MaxBatchSize {
+		return NewRPCErrorResponse("", RPCErrorInvalidRequest,
+			fmt.Sprintf("Batch is too large. Maximum number of the requests is %d", MaxBatchSize))
+	}
+
+	responses := make([]RPCResponse, 0, len(batch))
+	for _, raw := range batch {
+		resp := js.handle(ctx, raw, requester)
+		if !isNotification(raw) {
+			responses = append(responses, resp)
+		}
+	}
+	if len(responses) == 0 {
+		return nil
+	}
+	return responses
+}
+
+// isNotification returns true if the request has a method and no id
+func isNotification(data []byte) bool {
+	var req map[string]json.RawMessage
+	if err := json.Unmarshal(data, &req); err != nil {
+		return false
+	}
+	_, hasID := req["id"]
+	_, hasMethod := req["method"]
+	return hasMethod && !hasID
+}
 
+func (js *JSONService) handle(ctx context.Context, data []byte, requester interface{}) RPCResponse {
 	// Create new request object and unmarshal.
 	req := &RPCRequest{}
-	decoder := json.NewDecoder(r.Body)
-	errU := decoder.Decode(req)
+	errU := json.Unmarshal(data, req)
 
 	// Error when decoding.
 	if errU != nil {
-		js.writeError("Failed to parse request: "+errU.Error(), "",
-			RPCErrorParseError, w)
-		return
+		return NewRPCErrorResponse("", RPCErrorParseError, "Failed to parse request: "+errU.Error())
 	}
 
 	// Wrong protocol version.
 	if req.JSONRPC != "2.0" {
-		js.writeError("Wrong protocol version: "+req.JSONRPC, req.Id,
-			RPCErrorInvalidRequest, w)
-		return
+		return NewRPCErrorResponse(req.Id, RPCErrorInvalidRequest, "Wrong protocol version: "+req.JSONRPC)
 	}
 
 	mName := req.Method
 
-	if handler, ok := js.defaultHandlers[mName]; ok {
-		log.Debug("Request received",
-			"id", req.Id,
-			"method", req.Method)
-		resp, errCode, err := handler(req, w)
-		if err != nil {
-			js.writeError(err.Error(), req.Id, errCode, w)
-		} else {
-			js.writeResponse(req.Id, resp, w)
-		}
-	} else {
-		js.writeError("Method not found: "+mName, req.Id, RPCErrorMethodNotFound, w)
+	handler, ok := js.defaultHandlers[mName]
+	if !ok {
+		return NewRPCErrorResponse(req.Id, RPCErrorMethodNotFound, "Method not found: "+mName)
 	}
-}
 
-// Helper for writing error responses.
-func (js *JSONService) writeError(msg, id string, code int, w http.ResponseWriter) {
-	response := NewRPCErrorResponse(id, code, msg)
-	err := js.codec.Encode(response, w)
-	// If there's an error here all bets are off.
+	if err := js.authenticator.Authorize(mName, AccessLevel(ctx)); err != nil {
+		return NewRPCErrorResponse(req.Id, RPCErrorUnauthorized, err.Error())
+	}
+	if err := js.limiter.Allow(ClientIP(ctx), mName); err != nil {
+		return NewRPCErrorResponse(req.Id, RPCErrorLimitExceeded, err.Error())
+	}
+	release, err := js.limiter.Acquire()
 	if err != nil {
-		http.Error(w, "Failed to marshal standard error response: "+err.Error(), 500)
-		return
+		return NewRPCErrorResponse(req.Id, RPCErrorLimitExceeded, err.Error())
 	}
-	w.WriteHeader(200)
+	defer release()
+
+	logger.Debug("Request received",
+		"id", req.Id,
+		"method", req.Method)
+	start := time.Now()
+	resp, errCode, err := handler(req, requester)
+	metrics.RPCRequest("jsonrpc", mName, time.Since(start))
+	if err != nil {
+		return NewRPCErrorResponse(req.Id, errCode, err.Error())
+	}
+	return NewRPCResponse(req.Id, resp)
 }
 
 // Helper for writing responses.
-func (js *JSONService) writeResponse(id string, result interface{}, w http.ResponseWriter) {
-	response := NewRPCResponse(id, result)
+func (js *JSONService) write(response interface{}, w http.ResponseWriter) {
 	err := js.codec.Encode(response, w)
+	// If there's an error here all bets are off.
 	if err != nil {
-		js.writeError("Internal error: "+err.Error(), id, RPCErrorInternalError, w)
+		http.Error(w, "Failed to marshal response: "+err.Error(), 500)
 		return
 	}
 	w.WriteHeader(200)
@@ -0,0 +1,172 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gallactic/gallactic/rpc/auth"
+	rpcConfig "github.com/gallactic/gallactic/rpc/config"
+	"github.com/gallactic/gallactic/rpc/limit"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestJSONService() *JSONService {
+	return &JSONService{
+		codec: NewTCodec(),
+		defaultHandlers: map[string]RequestHandlerFunc{
+			"echo": func(request *RPCRequest, requester interface{}) (interface{}, int, error) {
+				var params interface{}
+				if err := json.Unmarshal(request.Params, &params); err != nil {
+					return nil, RPCErrorInvalidParams, err
+				}
+				return params, 0, nil
+			},
+		},
+	}
+}
+
+func encodeResponse(t *testing.T, js *JSONService, msg string) string {
+	bs, err := js.codec.EncodeBytes(js.ProcessMessage(context.Background(), []byte(msg), nil))
+	require.NoError(t, err)
+	return string(bs)
+}
+
+func TestProcessSingleRequest(t *testing.T) {
+	js := newTestJSONService()
+
+	assert.JSONEq(t, `{"result":"hello","id":"1","jsonrpc":"2.0"}`,
+		encodeResponse(t, js, `{"jsonrpc":"2.0","id":"1","method":"echo","params":"hello"}`))
+	assert.JSONEq(t, `{"error":{"code":-32601,"message":"Method not found: foo"},"id":"2","jsonrpc":"2.0"}`,
+		encodeResponse(t, js, `{"jsonrpc":"2.0","id":"2","method":"foo","params":{}}`))
+	assert.JSONEq(t, `{"error":{"code":-32600,"message":"Wrong protocol version: 1.0"},"id":"3","jsonrpc":"2.0"}`,
+		encodeResponse(t, js, `{"jsonrpc":"1.0","id":"3","method":"echo","params":{}}`))
+}
+
+func TestProcessBatchRequest(t *testing.T) {
+	js := newTestJSONService()
+
+	batch := ` [
+		{"jsonrpc":"2.0","id":"1","method":"echo","params":1},
+		{"jsonrpc":"2.0","id":"2","method":"foo","params":{}},
+		{"jsonrpc":"2.0","id":"3","method":"echo","params":[1,2]}
+	]`
+	assert.JSONEq(t, `[
+		{"result":1,"id":"1","jsonrpc":"2.0"},
+		{"error":{"code":-32601,"message":"Method not found: foo"},"id":"2","jsonrpc":"2.0"},
+		{"result":[1,2],"id":"3","jsonrpc":"2.0"}
+	]`, encodeResponse(t, js, batch))
+
+	assert.JSONEq(t, `{"error":{"code":-32600,"message":"Empty batch"},"id":"","jsonrpc":"2.0"}`,
+		encodeResponse(t, js, `[]`))
+
+	// The notifications have no response
+	assert.JSONEq(t, `[{"result":1,"id":"1","jsonrpc":"2.0"}]`, encodeResponse(t, js, `[
+		{"jsonrpc":"2.0","id":"1","method":"echo","params":1},
+		{"jsonrpc":"2.0","method":"echo","params":2},
+		{"jsonrpc":"2.0","method":"foo"}
+	]`))
+	assert.Nil(t, js.ProcessMessage(context.Background(), []byte(`[{"jsonrpc":"2.0","method":"echo","params":1}]`), nil))
+
+	res := &RPCErrorResponse{}
+	require.NoError(t, json.Unmarshal([]byte(encodeResponse(t, js, `[{"jsonrpc":"2.0"`)), res))
+	assert.Equal(t, RPCErrorParseError, res.Error.Code)
+}
+
+func TestProcessAuthorizedRequest(t *testing.T) {
+	js := newTestJSONService()
+	js.defaultHandlers["secret"] = js.defaultHandlers["echo"]
+	authenticator, err := auth.NewAuthenticator(&auth.Config{
+		Enabled: true,
+		APIKeys: []auth.APIKey{{Key: "user-key", Level: "user"}},
+	}, map[string]auth.Level{"secret": auth.User})
+	require.NoError(t, err)
+	js.authenticator = authenticator
+
+	router := gin.New()
+	router.Use(authMW(authenticator))
+	NewJSONServer(js).Start(&rpcConfig.ServerConfig{HTTP: rpcConfig.HTTP{JsonRpcEndpoint: "/rpc"}}, router)
+
+	post := func(msg string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/rpc", strings.NewReader(msg))
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	batch := `[
+		{"jsonrpc":"2.0","id":"1","method":"echo","params":1},
+		{"jsonrpc":"2.0","id":"2","method":"secret","params":2}
+	]`
+	w := post(batch, nil)
+	assert.Equal(t, 200, w.Code)
+	assert.JSONEq(t, `[
+		{"result":1,"id":"1","jsonrpc":"2.0"},
+		{"error":{"code":-32001,"message":"Method secret requires 'user' access"},"id":"2","jsonrpc":"2.0"}
+	]`, w.Body.String())
+
+	w = post(batch, map[string]string{"X-API-Key": "user-key"})
+	assert.JSONEq(t, `[
+		{"result":1,"id":"1","jsonrpc":"2.0"},
+		{"result":2,"id":"2","jsonrpc":"2.0"}
+	]`, w.Body.String())
+
+	w = post(batch, map[string]string{"Authorization": "Bearer user-key"})
+	assert.JSONEq(t, `[
+		{"result":1,"id":"1","jsonrpc":"2.0"},
+		{"result":2,"id":"2","jsonrpc":"2.0"}
+	]`, w.Body.String())
+
+	w = post(batch, map[string]string{"X-API-Key": "wrong-key"})
+	assert.Equal(t, 401, w.Code)
+}
+
+func TestProcessLimitedRequest(t *testing.T) {
+	js := newTestJSONService()
+	limiter, err := limit.NewLimiter(&limit.Config{
+		RequestsPerSecond: 0.001,
+		Burst:             2,
+		MaxRequestSize:    200,
+	})
+	require.NoError(t, err)
+	js.limiter = limiter
+
+	router := gin.New()
+	router.Use(clientMW(limiter))
+	NewJSONServer(js).Start(&rpcConfig.ServerConfig{HTTP: rpcConfig.HTTP{JsonRpcEndpoint: "/rpc"}}, router)
+
+	post := func(msg string, headers ...string) string {
+		req := httptest.NewRequest("POST", "/rpc", strings.NewReader(msg))
+		for i := 0; i+1 < len(headers); i += 2 {
+			req.Header.Set(headers[i], headers[i+1])
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Body.String()
+	}
+
+	// Each request of a batch is counted
+	assert.JSONEq(t, `[
+		{"result":1,"id":"1","jsonrpc":"2.0"},
+		{"result":2,"id":"2","jsonrpc":"2.0"},
+		{"error":{"code":-32005,"message":"Rate limit exceeded"},"id":"3","jsonrpc":"2.0"}
+	]`, post(`[
+		{"jsonrpc":"2.0","id":"1","method":"echo","params":1},
+		{"jsonrpc":"2.0","id":"2","method":"echo","params":2},
+		{"jsonrpc":"2.0","id":"3","method":"echo","params":3}
+	]`))
+
+	// The caller can't get a new bucket by forwarded headers
+	assert.JSONEq(t, `{"error":{"code":-32005,"message":"Rate limit exceeded"},"id":"4","jsonrpc":"2.0"}`,
+		post(`{"jsonrpc":"2.0","id":"4","method":"echo","params":4}`, "X-Forwarded-For", "9.9.9.9"))
+
+	assert.JSONEq(t, `{"error":{"code":-32005,"message":"Request is too large"},"id":"","jsonrpc":"2.0"}`,
+		post(`{"jsonrpc":"2.0","id":"1","method":"echo","params":"`+strings.Repeat("a", 200)+`"}`))
+}
@@ -22,6 +22,11 @@ import (
 // JSON-RPC 2.0 error codes.
 const (
 	RPCErrorServerError    = -32000
+	RPCErrorUnauthorized   = -32001
+	RPCErrorTxRejected     = -32002
+	RPCErrorTxTimeout      = -32003
+	RPCErrorLowFee         = -32004
+	RPCErrorLimitExceeded  = -32005
 	RPCErrorInvalidRequest = -32600
 	RPCErrorMethodNotFound = -32601
 	RPCErrorInvalidParams  = -32602
@@ -59,6 +64,13 @@ type (
 		JSONRPC string    `json:"jsonrpc"`
 	}
 
+	// RPCNotification is a request without id. It is sent by the server to push the subscription events
+	RPCNotification struct {
+		JSONRPC string      `json:"jsonrpc"`
+		Method  string      `json:"method"`
+		Params  interface{} `json:"params"`
+	}
+
 	// RPCError MUST be included in the Response object if an error occurred
 	RPCError struct {
 		Code    int    `json:"code"`
@@ -101,6 +113,15 @@ func NewRPCErrorResponse(id string, code int, message string) RPCResponse {
 	})
 }
 
+// NewRPCNotification creates a new notification object
+func NewRPCNotification(method string, params interface{}) *RPCNotification {
+	return &RPCNotification{
+		JSONRPC: "2.0",
+		Method:  method,
+		Params:  params,
+	}
+}
+
 // AssertIsRPCResponse implements a marker method for RPCResultResponse
 // to implement the interface RPCResponse
 func (rpcResultResponse *RPCResultResponse) AssertIsRPCResponse() bool {
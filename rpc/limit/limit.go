@@ -0,0 +1,260 @@
+package limit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrRateLimited     = errors.New("Rate limit exceeded")
+	ErrTooManyRequests = errors.New("Too many concurrent requests")
+	ErrTooManyStreams  = errors.New("Too many open streams")
+)
+
+// Time between removing the idle clients
+const sweepInterval = time.Minute
+
+// Config of the limits of the RPC servers. Zero means no limit.
+type Config struct {
+	// RequestsPerSecond and Burst limit the requests of each client IP
+	RequestsPerSecond float64
+	Burst             int
+	// Methods limits the requests of each client IP to the methods, like `gallactic.getAccounts`
+	Methods map[string]Rate
+	// MaxConcurrentRequests is the number of the requests which are processed at the same time
+	MaxConcurrentRequests int
+	// MaxStreams is the number of the gRPC streams, like the block subscriptions, which are open at the same time
+	MaxStreams int
+	// MaxRequestSize is the maximum size of a request in bytes
+	MaxRequestSize int64
+	// MaxPageLimit is the maximum number of the items in a response
+	MaxPageLimit int
+	// TrustedProxies are the reverse proxies in front of the RPC servers, like `10.0.0.1` or `10.0.0.0/8`.
+	// The client IP is taken from `X-Forwarded-For` only if the request is from a trusted proxy.
+	TrustedProxies []string
+}
+
+// Rate of a token bucket. Burst is the size of the bucket, it is
+// the rate rounded up if not set.
+type Rate struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		MaxStreams:     100,
+		MaxRequestSize: 1024 * 1024,
+		MaxPageLimit:   1000,
+	}
+}
+
+func (r Rate) burst() float64 {
+	if r.Burst > 0 {
+		return float64(r.Burst)
+	}
+	return math.Max(1, math.Ceil(r.RequestsPerSecond))
+}
+
+type bucket struct {
+	rate   Rate
+	tokens float64
+	last   time.Time
+}
+
+func (b *bucket) refill(now time.Time) {
+	b.tokens += now.Sub(b.last).Seconds() * b.rate.RequestsPerSecond
+	if burst := b.rate.burst(); b.tokens > burst {
+		b.tokens = burst
+	}
+	b.last = now
+}
+
+// Limiter limits the rate and the number of the concurrent requests
+type Limiter struct {
+	conf      Config
+	lk        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	sem       chan struct{}
+	streams   chan struct{}
+	proxies   []*net.IPNet
+}
+
+func NewLimiter(conf *Config) (*Limiter, error) {
+	if conf == nil {
+		conf = DefaultConfig()
+	}
+	l := &Limiter{
+		conf:    *conf,
+		buckets: make(map[string]*bucket),
+	}
+	if conf.MaxConcurrentRequests > 0 {
+		l.sem = make(chan struct{}, conf.MaxConcurrentRequests)
+	}
+	if conf.MaxStreams > 0 {
+		l.streams = make(chan struct{}, conf.MaxStreams)
+	}
+	for _, proxy := range conf.TrustedProxies {
+		if !strings.Contains(proxy, "/") {
+			if ip := net.ParseIP(proxy); ip != nil && ip.To4() != nil {
+				proxy += "/32"
+			} else {
+				proxy += "/128"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid trusted proxy: %v", err)
+		}
+		l.proxies = append(l.proxies, ipNet)
+	}
+	return l, nil
+}
+
+// ClientIP returns the IP address of the client from remoteAddr, the address of the peer of the request.
+// If the peer is a trusted proxy, the client is the last address of forwardedFor, the `X-Forwarded-For`
+// header, which is not a trusted proxy. The addresses which the client added itself are never used.
+func (l *Limiter) ClientIP(remoteAddr, forwardedFor string) string {
+	ip := remoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		ip = host
+	}
+	if !l.trusted(ip) {
+		return ip
+	}
+	hops := strings.Split(forwardedFor, ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		ip = hop
+		if !l.trusted(hop) {
+			break
+		}
+	}
+	return ip
+}
+
+func (l *Limiter) trusted(ip string) bool {
+	if l == nil {
+		return false
+	}
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, proxy := range l.proxies {
+		if proxy.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// Allow takes a token from the buckets of the client and of the method of the client.
+// ErrRateLimited is returned if any of them is empty.
+func (l *Limiter) Allow(client, method string) error {
+	if l == nil {
+		return nil
+	}
+	return l.allow(client, method, time.Now())
+}
+
+func (l *Limiter) allow(client, method string, now time.Time) error {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	if now.Sub(l.lastSweep) >= sweepInterval {
+		l.sweep(now)
+	}
+	if l.conf.RequestsPerSecond > 0 {
+		rate := Rate{RequestsPerSecond: l.conf.RequestsPerSecond, Burst: l.conf.Burst}
+		if !l.take(client, rate, now) {
+			return ErrRateLimited
+		}
+	}
+	if rate, ok := l.conf.Methods[method]; ok && rate.RequestsPerSecond > 0 {
+		if !l.take(client+" "+method, rate, now) {
+			return ErrRateLimited
+		}
+	}
+	return nil
+}
+
+func (l *Limiter) take(key string, rate Rate, now time.Time) bool {
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{rate: rate, tokens: rate.burst(), last: now}
+		l.buckets[key] = b
+	}
+	b.refill(now)
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// sweep removes the buckets which are full, a new bucket is the same
+func (l *Limiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		b.refill(now)
+		if b.tokens >= b.rate.burst() {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+// Acquire reserves a slot for a request. The returned function should be called to release the slot.
+// ErrTooManyRequests is returned if all the slots are taken.
+func (l *Limiter) Acquire() (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	return acquire(l.sem, ErrTooManyRequests)
+}
+
+// AcquireStream reserves a slot for a stream, which is held until the stream is closed.
+// ErrTooManyStreams is returned if all the slots are taken.
+func (l *Limiter) AcquireStream() (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	return acquire(l.streams, ErrTooManyStreams)
+}
+
+func acquire(sem chan struct{}, errFull error) (func(), error) {
+	if sem == nil {
+		return func() {}, nil
+	}
+	select {
+	case sem <- struct{}{}:
+		return func() { <-sem }, nil
+	default:
+		return nil, errFull
+	}
+}
+
+// MaxRequestSize returns the maximum size of a request in bytes, or zero if there is no limit
+func (l *Limiter) MaxRequestSize() int64 {
+	if l == nil {
+		return 0
+	}
+	return l.conf.MaxRequestSize
+}
+
+// MaxPageLimit returns the maximum number of the items in a response, which is at most max
+func (l *Limiter) MaxPageLimit(max int) int {
+	if l != nil && l.conf.MaxPageLimit > 0 && l.conf.MaxPageLimit < max {
+		return l.conf.MaxPageLimit
+	}
+	return max
+}
@@ -0,0 +1,110 @@
+package limit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllow(t *testing.T) {
+	l, err := NewLimiter(&Config{
+		RequestsPerSecond: 2,
+		Burst:             3,
+		Methods:           map[string]Rate{"getAccounts": {RequestsPerSecond: 0.5}},
+	})
+	require.NoError(t, err)
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, l.allow("1.1.1.1", "getStatus", now))
+	}
+	assert.Equal(t, ErrRateLimited, l.allow("1.1.1.1", "getStatus", now))
+	// Other clients have their own buckets
+	assert.NoError(t, l.allow("2.2.2.2", "getStatus", now))
+
+	// Refilled by 2 tokens per second
+	now = now.Add(time.Second)
+	assert.NoError(t, l.allow("1.1.1.1", "getStatus", now))
+	assert.NoError(t, l.allow("1.1.1.1", "getStatus", now))
+	assert.Equal(t, ErrRateLimited, l.allow("1.1.1.1", "getStatus", now))
+
+	// One request every two seconds
+	assert.NoError(t, l.allow("2.2.2.2", "getAccounts", now))
+	assert.Equal(t, ErrRateLimited, l.allow("2.2.2.2", "getAccounts", now))
+	now = now.Add(2 * time.Second)
+	assert.NoError(t, l.allow("2.2.2.2", "getAccounts", now))
+
+	// Full buckets are removed
+	now = now.Add(sweepInterval)
+	require.NoError(t, l.allow("3.3.3.3", "getStatus", now))
+	assert.Len(t, l.buckets, 1)
+}
+
+func TestAcquire(t *testing.T) {
+	l, err := NewLimiter(&Config{MaxConcurrentRequests: 2})
+	require.NoError(t, err)
+	release1, err := l.Acquire()
+	require.NoError(t, err)
+	_, err = l.Acquire()
+	require.NoError(t, err)
+	_, err = l.Acquire()
+	assert.Equal(t, ErrTooManyRequests, err)
+	release1()
+	_, err = l.Acquire()
+	assert.NoError(t, err)
+
+	// The streams have their own slots
+	l, err = NewLimiter(&Config{MaxConcurrentRequests: 1, MaxStreams: 1})
+	require.NoError(t, err)
+	release1, err = l.AcquireStream()
+	require.NoError(t, err)
+	_, err = l.AcquireStream()
+	assert.Equal(t, ErrTooManyStreams, err)
+	_, err = l.Acquire()
+	assert.NoError(t, err)
+	release1()
+	_, err = l.AcquireStream()
+	assert.NoError(t, err)
+}
+
+func TestNoLimits(t *testing.T) {
+	var l *Limiter
+	assert.NoError(t, l.Allow("1.1.1.1", "getStatus"))
+	release, err := l.Acquire()
+	require.NoError(t, err)
+	release()
+	assert.Equal(t, int64(0), l.MaxRequestSize())
+	assert.Equal(t, 1000, l.MaxPageLimit(1000))
+
+	l, err = NewLimiter(nil)
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, l.Allow("1.1.1.1", "getStatus"))
+	}
+	assert.Equal(t, 1000, l.MaxPageLimit(1000))
+	l, err = NewLimiter(&Config{MaxPageLimit: 50})
+	require.NoError(t, err)
+	assert.Equal(t, 50, l.MaxPageLimit(1000))
+}
+
+func TestClientIP(t *testing.T) {
+	l, err := NewLimiter(&Config{TrustedProxies: []string{"10.0.0.1", "192.168.0.0/16"}})
+	require.NoError(t, err)
+
+	// The headers of the untrusted peers are ignored
+	assert.Equal(t, "1.1.1.1", l.ClientIP("1.1.1.1:1234", "2.2.2.2"))
+	assert.Equal(t, "127.0.0.1", l.ClientIP("127.0.0.1:1234", "2.2.2.2"))
+	// The addresses added by the trusted proxies are used
+	assert.Equal(t, "2.2.2.2", l.ClientIP("10.0.0.1:1234", "2.2.2.2"))
+	assert.Equal(t, "2.2.2.2", l.ClientIP("10.0.0.1:1234", "3.3.3.3, 2.2.2.2, 192.168.1.1"))
+	assert.Equal(t, "10.0.0.1", l.ClientIP("10.0.0.1:1234", ""))
+	assert.Equal(t, "10.0.0.1", l.ClientIP("10.0.0.1:1234", "garbage"))
+
+	var nl *Limiter
+	assert.Equal(t, "1.1.1.1", nl.ClientIP("1.1.1.1:1234", "2.2.2.2"))
+
+	_, err = NewLimiter(&Config{TrustedProxies: []string{"not-an-ip"}})
+	assert.Error(t, err)
+}
@@ -1,7 +1,13 @@
 package rpc
 
 import (
+	"fmt"
+
 	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/logging"
+	e "github.com/gallactic/gallactic/errors"
+	"github.com/gallactic/gallactic/rpc/auth"
+	"github.com/gallactic/gallactic/rpc/limit"
 	"github.com/gallactic/gallactic/txs"
 )
 
@@ -11,30 +17,60 @@ type RequestHandlerFunc func(request *RPCRequest, requester interface{}) (interf
 const (
 	GALLACTIC = "gallactic."
 
-	GET_ACCOUNTS        = GALLACTIC + "getAccounts"
-	GET_ACCOUNT         = GALLACTIC + "getAccount"
-	GET_VALIDATOR       = GALLACTIC + "getValidator"
-	GET_STORAGE         = GALLACTIC + "getStorage"
-	GET_STORAGE_AT      = GALLACTIC + "getStorageAt"
-	GET_STATUS          = GALLACTIC + "getStatus"
-	GET_LATEST_BLOCK    = GALLACTIC + "getLatestBlock"
-	GET_BLOCKS          = GALLACTIC + "getBlocks"
-	GET_BLOCK           = GALLACTIC + "getBlock"
-	GET_CONSENSUS_STATE = GALLACTIC + "getConsensusState"
-	GET_VALIDATORS      = GALLACTIC + "getValidators"
-	GET_NETWORK_INFO    = GALLACTIC + "getNetworkInfo"
-	GET_CHAIN_ID        = GALLACTIC + "getChainId"
-	GET_PEERS           = GALLACTIC + "getPeers"
-	GET_GENESIS         = GALLACTIC + "getGenesis"
-	BROADCAST_TX        = GALLACTIC + "broadcastTx"
-	GET_UNCONFIRMED_TXS = GALLACTIC + "getUnconfirmedTxs"
-	GET_BLOCK_TXS       = GALLACTIC + "getBlockTxs"
-	GET_LastBlock_Info  = GALLACTIC + "getLastBlockInfo"
+	GET_ACCOUNTS          = GALLACTIC + "getAccounts"
+	GET_ACCOUNT           = GALLACTIC + "getAccount"
+	GET_MEMPOOL_ACCOUNT   = GALLACTIC + "getMempoolAccount"
+	GET_VALIDATOR         = GALLACTIC + "getValidator"
+	GET_STORAGE           = GALLACTIC + "getStorage"
+	GET_STORAGE_AT        = GALLACTIC + "getStorageAt"
+	GET_STATUS            = GALLACTIC + "getStatus"
+	GET_LATEST_BLOCK      = GALLACTIC + "getLatestBlock"
+	GET_BLOCKS            = GALLACTIC + "getBlocks"
+	GET_BLOCK             = GALLACTIC + "getBlock"
+	GET_CONSENSUS_STATE   = GALLACTIC + "getConsensusState"
+	GET_VALIDATORS        = GALLACTIC + "getValidators"
+	GET_VALIDATOR_SET     = GALLACTIC + "getValidatorSet"
+	GET_VALIDATOR_HISTORY = GALLACTIC + "getValidatorHistory"
+	GET_NETWORK_INFO      = GALLACTIC + "getNetworkInfo"
+	GET_CHAIN_ID          = GALLACTIC + "getChainId"
+	GET_PEERS             = GALLACTIC + "getPeers"
+	GET_GENESIS           = GALLACTIC + "getGenesis"
+	BROADCAST_TX          = GALLACTIC + "broadcastTx"
+	BROADCAST_TX_ASYNC    = GALLACTIC + "broadcastTxAsync"
+	BROADCAST_TX_COMMIT   = GALLACTIC + "broadcastTxCommit"
+	GET_UNCONFIRMED_TXS   = GALLACTIC + "getUnconfirmedTxs"
+	GET_BLOCK_TXS         = GALLACTIC + "getBlockTxs"
+	GET_ACCOUNT_TXS       = GALLACTIC + "getAccountTxs"
+	GET_LastBlock_Info    = GALLACTIC + "getLastBlockInfo"
+	SUBSCRIBE             = GALLACTIC + "subscribe"
+	UNSUBSCRIBE           = GALLACTIC + "unsubscribe"
+
+	// Notification of the subscriptions
+	SUBSCRIPTION = GALLACTIC + "subscription"
+
+	// Admin methods
+	BROADCAST_TX_AUTO = GALLACTIC + "broadcastTxAuto"
+	GET_LOG_LEVELS    = GALLACTIC + "getLogLevels"
+	SET_LOG_LEVEL     = GALLACTIC + "setLogLevel"
 )
 
-func loadGallacticMethods(codec Codec, service *Service, rpcServiceMap map[string]RequestHandlerFunc) {
+// MethodLevels are the default access levels of the methods if the authentication is enabled.
+// The other methods are public.
+var MethodLevels = map[string]auth.Level{
+	BROADCAST_TX:        auth.User,
+	BROADCAST_TX_ASYNC:  auth.User,
+	BROADCAST_TX_COMMIT: auth.User,
+	BROADCAST_TX_AUTO:   auth.Admin,
+	GET_CONSENSUS_STATE: auth.Admin,
+	GET_NETWORK_INFO:    auth.Admin,
+	GET_PEERS:           auth.Admin,
+	GET_LOG_LEVELS:      auth.Admin,
+	SET_LOG_LEVEL:       auth.Admin,
+}
+
+func loadGallacticMethods(codec Codec, service *Service, maxPageLimit int, rpcServiceMap map[string]RequestHandlerFunc) {
 
-	accountFilterFactory := NewAccountFilterFactory()
+	accountFilterFactory := NewAccountFilterFactory(service.State())
 
 	rpcServiceMap[BROADCAST_TX] = func(request *RPCRequest, requester interface{}) (interface{}, int, error) {
 
@@ -45,11 +81,53 @@ func loadGallacticMethods(codec Codec, service *Service, rpcServiceMap map[strin
 		}
 		receipt, err := service.Transactor().BroadcastTxSync(txEnv)
 		if err != nil {
+			if e.Code(err) == e.ErrInsufficientFee {
+				return nil, RPCErrorLowFee, err
+			}
 			return nil, RPCErrorInternalError, err
 		}
 		return receipt, 0, nil
 	}
 
+	// Returns once the transaction is checked and added to the mempool
+	rpcServiceMap[BROADCAST_TX_ASYNC] = func(request *RPCRequest, requester interface{}) (interface{}, int, error) {
+		txEnv := new(txs.Envelope)
+		err := codec.DecodeBytes(txEnv, request.Params)
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		receipt, err := service.Transactor().BroadcastTxAsync(txEnv)
+		if err != nil {
+			if e.Code(err) == e.ErrInsufficientFee {
+				return nil, RPCErrorLowFee, err
+			}
+			return nil, RPCErrorInternalError, err
+		}
+		return receipt, 0, nil
+	}
+
+	// Returns once the block which includes the transaction is committed
+	rpcServiceMap[BROADCAST_TX_COMMIT] = func(request *RPCRequest, requester interface{}) (interface{}, int, error) {
+		txEnv := new(txs.Envelope)
+		err := codec.DecodeBytes(txEnv, request.Params)
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		result, err := service.Transactor().BroadcastTxCommit(txEnv)
+		if err != nil {
+			switch e.Code(err) {
+			case e.ErrTxRejected, e.ErrTxExpired:
+				return nil, RPCErrorTxRejected, err
+			case e.ErrTimeOut:
+				return nil, RPCErrorTxTimeout, err
+			case e.ErrInsufficientFee:
+				return nil, RPCErrorLowFee, err
+			}
+			return nil, RPCErrorInternalError, err
+		}
+		return result, 0, nil
+	}
+
 	rpcServiceMap[GET_ACCOUNTS] = func(request *RPCRequest, requester interface{}) (interface{}, int, error) {
 		input := &FilterListInput{}
 		if len(request.Params) > 0 {
@@ -62,10 +140,18 @@ func loadGallacticMethods(codec Codec, service *Service, rpcServiceMap map[strin
 		if err != nil {
 			return nil, RPCErrorInvalidParams, err
 		}
+		page, err := input.page(false, maxPageLimit)
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		from, err := input.addressCursor()
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
 		list, err := service.ListAccounts(func(account *account.Account) bool {
 			return filter.Match(account)
 
-		})
+		}, from, page)
 		if err != nil {
 			return nil, RPCErrorInternalError, err
 		}
@@ -86,6 +172,41 @@ func loadGallacticMethods(codec Codec, service *Service, rpcServiceMap map[strin
 		return acc, 0, nil
 	}
 
+	rpcServiceMap[GET_ACCOUNT_TXS] = func(request *RPCRequest, requester interface{}) (interface{}, int, error) {
+		input := &AccountTxsInput{}
+		err := codec.DecodeBytes(input, request.Params)
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		page, err := input.page(true, maxPageLimit)
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		from, err := input.txCursor()
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		list, err := service.ListAccountTxs(input.Address, input.FromHeight, input.ToHeight, from, page)
+		if err != nil {
+			return nil, RPCErrorInternalError, err
+		}
+		return list, 0, nil
+	}
+
+	rpcServiceMap[GET_MEMPOOL_ACCOUNT] = func(request *RPCRequest, requester interface{}) (interface{}, int, error) {
+		input := &AddressInput{}
+		err := codec.DecodeBytes(input, request.Params)
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+
+		acc, err := service.GetMempoolAccount(input.Address)
+		if err != nil {
+			return nil, RPCErrorInternalError, err
+		}
+		return acc, 0, nil
+	}
+
 	rpcServiceMap[GET_VALIDATOR] = func(request *RPCRequest, requester interface{}) (interface{}, int, error) {
 		input := &AddressInput{}
 		err := codec.DecodeBytes(input, request.Params)
@@ -152,7 +273,15 @@ func loadGallacticMethods(codec Codec, service *Service, rpcServiceMap map[strin
 		if err != nil {
 			return nil, RPCErrorInvalidParams, err
 		}
-		blocks, err := service.ListBlocks(input.MinHeight, input.MaxHeight)
+		page, err := input.page(true, maxPageLimit)
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		from, err := input.heightCursor()
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		blocks, err := service.ListBlocks(input.MinHeight, input.MaxHeight, from, page)
 		if err != nil {
 			return nil, RPCErrorInternalError, err
 		}
@@ -202,13 +331,58 @@ func loadGallacticMethods(codec Codec, service *Service, rpcServiceMap map[strin
 	}
 
 	rpcServiceMap[GET_VALIDATORS] = func(request *RPCRequest, requester interface{}) (interface{}, int, error) {
-		validators, err := service.ListValidators()
+		input := &ValidatorsInput{}
+		if len(request.Params) > 0 {
+			err := codec.DecodeBytes(input, request.Params)
+			if err != nil {
+				return nil, RPCErrorInvalidParams, err
+			}
+		}
+		page, err := input.page(false, maxPageLimit)
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		from, err := input.addressCursor()
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		predicate, err := ValidatorStatusFilter(input.Status, service.BlockchainInfo().LastValidators())
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		validators, err := service.ListValidators(predicate, from, page)
 		if err != nil {
 			return nil, RPCErrorInternalError, err
 		}
 		return validators, 0, nil
 	}
 
+	rpcServiceMap[GET_VALIDATOR_SET] = func(request *RPCRequest, requester interface{}) (interface{}, int, error) {
+		input := &BlockInput{}
+		err := codec.DecodeBytes(input, request.Params)
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		set, err := service.GetValidatorSet(input.Height)
+		if err != nil {
+			return nil, RPCErrorInternalError, err
+		}
+		return set, 0, nil
+	}
+
+	rpcServiceMap[GET_VALIDATOR_HISTORY] = func(request *RPCRequest, requester interface{}) (interface{}, int, error) {
+		input := &AddressInput{}
+		err := codec.DecodeBytes(input, request.Params)
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		history, err := service.GetValidatorHistory(input.Address)
+		if err != nil {
+			return nil, RPCErrorInternalError, err
+		}
+		return history, 0, nil
+	}
+
 	rpcServiceMap[GET_NETWORK_INFO] = func(request *RPCRequest, requester interface{}) (interface{}, int, error) {
 		info, err := service.NetInfo()
 		if err != nil {
@@ -251,12 +425,92 @@ func loadGallacticMethods(codec Codec, service *Service, rpcServiceMap map[strin
 		return storage, 0, nil
 	}
 
+	rpcServiceMap[SUBSCRIBE] = func(request *RPCRequest, requester interface{}) (interface{}, int, error) {
+		session, ok := requester.(*WebSocketSession)
+		if !ok {
+			return nil, RPCErrorInvalidRequest, fmt.Errorf("Subscriptions are only available over WebSocket")
+		}
+		input := &SubscribeInput{}
+		err := codec.DecodeBytes(input, request.Params)
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		query, err := subscriptionQuery(input)
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		subID, err := session.Subscribe(query)
+		if err != nil {
+			return nil, RPCErrorInternalError, err
+		}
+		return &SubscribeOutput{SubscriptionID: subID}, 0, nil
+	}
+
+	rpcServiceMap[UNSUBSCRIBE] = func(request *RPCRequest, requester interface{}) (interface{}, int, error) {
+		session, ok := requester.(*WebSocketSession)
+		if !ok {
+			return nil, RPCErrorInvalidRequest, fmt.Errorf("Subscriptions are only available over WebSocket")
+		}
+		input := &UnsubscribeInput{}
+		err := codec.DecodeBytes(input, request.Params)
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		result, err := session.Unsubscribe(input.SubscriptionID)
+		if err != nil {
+			return nil, RPCErrorInternalError, err
+		}
+		return &UnsubscribeOutput{Result: result}, 0, nil
+	}
 }
 
-func GetMethods(codec Codec, service *Service) map[string]RequestHandlerFunc {
+func loadAdminMethods(codec Codec, service *Service, rpcServiceMap map[string]RequestHandlerFunc) {
+
+	// Sets the sequence of the unsigned transaction and signs it with a node-managed key.
+	// Returns once the transaction is checked and added to the mempool
+	rpcServiceMap[BROADCAST_TX_AUTO] = func(request *RPCRequest, requester interface{}) (interface{}, int, error) {
+		txEnv := new(txs.Envelope)
+		err := codec.DecodeBytes(txEnv, request.Params)
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		receipt, err := service.Transactor().BroadcastTxAuto(txEnv.Tx, txEnv.ValidUntilHeight)
+		if err != nil {
+			if e.Code(err) == e.ErrInsufficientFee {
+				return nil, RPCErrorLowFee, err
+			}
+			return nil, RPCErrorInternalError, err
+		}
+		return receipt, 0, nil
+	}
+
+	rpcServiceMap[GET_LOG_LEVELS] = func(request *RPCRequest, requester interface{}) (interface{}, int, error) {
+		level, modules := logging.Levels()
+		return &LogLevelsOutput{Level: level, Modules: modules}, 0, nil
+	}
+
+	rpcServiceMap[SET_LOG_LEVEL] = func(request *RPCRequest, requester interface{}) (interface{}, int, error) {
+		input := &LogLevelInput{}
+		err := codec.DecodeBytes(input, request.Params)
+		if err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		if err := logging.SetLevel(input.Module, input.Level); err != nil {
+			return nil, RPCErrorInvalidParams, err
+		}
+		level, modules := logging.Levels()
+		return &LogLevelsOutput{Level: level, Modules: modules}, 0, nil
+	}
+}
+
+// GetMethods returns the JSON-RPC methods. Admin methods are loaded if enableAdmin is set.
+func GetMethods(codec Codec, service *Service, enableAdmin bool, limiter *limit.Limiter) map[string]RequestHandlerFunc {
 
 	rpcServiceMap := make(map[string]RequestHandlerFunc)
-	loadGallacticMethods(codec, service, rpcServiceMap)
+	loadGallacticMethods(codec, service, limiter.MaxPageLimit(MaxPageLimit), rpcServiceMap)
+	if enableAdmin {
+		loadAdminMethods(codec, service, rpcServiceMap)
+	}
 
 	return rpcServiceMap
 
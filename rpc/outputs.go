@@ -6,6 +6,8 @@ import (
 
 	"github.com/gallactic/gallactic/common/binary"
 	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/blockchain"
+	"github.com/gallactic/gallactic/core/indexer"
 	"github.com/gallactic/gallactic/core/proposal"
 	"github.com/gallactic/gallactic/core/validator"
 	"github.com/gallactic/gallactic/crypto"
@@ -27,6 +29,7 @@ type StorageOutput struct {
 type AccountsOutput struct {
 	BlockHeight uint64
 	Accounts    []*account.Account
+	NextCursor  string `json:"nextCursor,omitempty"`
 }
 
 type DumpstorageOutput struct {
@@ -41,6 +44,7 @@ type StorageItem struct {
 type BlocksOutput struct {
 	LastHeight uint64
 	BlockMetas []*tmTypes.BlockMeta
+	NextCursor string `json:"nextCursor,omitempty"`
 }
 
 type BlockOutput struct {
@@ -83,6 +87,19 @@ type StatusOutput struct {
 	NodeVersion       string
 }
 
+type HealthOutput struct {
+	CatchingUp        bool
+	Peers             int
+	LatestBlockHeight uint64
+	LatestBlockTime   time.Time
+	// Seconds since the last block
+	LatestBlockAge float64
+	IsValidator    bool
+	Ready          bool
+	// Why the node is not ready
+	Reasons []string `json:",omitempty"`
+}
+
 type LastBlockInfoOutput struct {
 	LastBlockHeight uint64
 	LastBlockTime   time.Time
@@ -110,6 +127,7 @@ type ValidatorsOutput struct {
 	BlockHeight         uint64
 	BondedValidators    []*validator.Validator
 	UnbondingValidators []*validator.Validator
+	NextCursor          string `json:"nextCursor,omitempty"`
 }
 
 type DumpConsensusStateOutput struct {
@@ -129,6 +147,17 @@ type ValidatorOutput struct {
 	Validator *validator.Validator
 }
 
+type ValidatorSetOutput struct {
+	Height     uint64
+	Validators []crypto.Address
+	Leavers    []crypto.Address
+}
+
+type ValidatorHistoryOutput struct {
+	Address crypto.Address
+	Events  []blockchain.ValidatorEvent
+}
+
 type BroadcastTxOutput struct {
 	txs.Receipt
 }
@@ -155,6 +184,31 @@ type BlockTxsOutput struct {
 	Txs   []txs.Envelope
 }
 
+type AccountTxsOutput struct {
+	Address    crypto.Address
+	Txs        []indexer.AccountTx
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+type SubscribeOutput struct {
+	SubscriptionID string `json:"subscriptionId"`
+}
+
+type UnsubscribeOutput struct {
+	Result bool `json:"result"`
+}
+
+// SubscriptionOutput is the params of the `gallactic.subscription` notification
+type SubscriptionOutput struct {
+	SubscriptionID string      `json:"subscriptionId"`
+	Result         interface{} `json:"result"`
+}
+
+type LogLevelsOutput struct {
+	Level   string
+	Modules map[string]string
+}
+
 // protobuf marshal,unmarshal and size methods
 func (p *Peer) Encode() ([]byte, error) {
 	return aminoCodec.MarshalBinaryLengthPrefixed(&p)
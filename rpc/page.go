@@ -0,0 +1,88 @@
+package rpc
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gallactic/gallactic/core/indexer"
+	"github.com/gallactic/gallactic/crypto"
+)
+
+const (
+	// DefaultPageLimit is the number of the items in a page if the limit is not set
+	DefaultPageLimit = 100
+	// MaxPageLimit is the maximum number of the items in a page
+	MaxPageLimit = 1000
+)
+
+// Page is the size and the order of a page of a listing
+type Page struct {
+	Limit   int
+	Reverse bool
+}
+
+// NewPage returns the page with the requested limit and order, which is "asc", "desc" or empty for
+// the default order. The limit is capped by maxLimit.
+func NewPage(limit int, order string, defaultReverse bool, maxLimit int) (Page, error) {
+	page := Page{Limit: limit, Reverse: defaultReverse}
+	if page.Limit < 0 {
+		return page, fmt.Errorf("Invalid limit %d", limit)
+	}
+	if page.Limit == 0 {
+		page.Limit = DefaultPageLimit
+	}
+	if page.Limit > maxLimit {
+		page.Limit = maxLimit
+	}
+
+	switch order {
+	case "":
+	case "asc":
+		page.Reverse = false
+	case "desc":
+		page.Reverse = true
+	default:
+		return page, fmt.Errorf("Invalid order '%s'. It should be 'asc' or 'desc'", order)
+	}
+	return page, nil
+}
+
+// AddressCursor returns the address in the cursor, or nil if the cursor is not set
+func AddressCursor(cursor string) (*crypto.Address, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	addr, err := crypto.AddressFromString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid cursor: %v", err)
+	}
+	return &addr, nil
+}
+
+// TxCursor returns the position of the transaction in the cursor, or nil if the cursor is not set
+func TxCursor(cursor string) (*indexer.Cursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	return indexer.ParseCursor(cursor)
+}
+
+// HeightCursor returns the height in the cursor, or zero if the cursor is not set
+func HeightCursor(cursor string) (uint64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	height, err := strconv.ParseUint(cursor, 10, 64)
+	if err != nil || height == 0 {
+		return 0, fmt.Errorf("Invalid cursor '%s'", cursor)
+	}
+	return height, nil
+}
+
+func (p *PageInput) page(defaultReverse bool, maxLimit int) (Page, error) {
+	return NewPage(p.Limit, p.Order, defaultReverse, maxLimit)
+}
+
+func (p *PageInput) addressCursor() (*crypto.Address, error) { return AddressCursor(p.Cursor) }
+func (p *PageInput) txCursor() (*indexer.Cursor, error)      { return TxCursor(p.Cursor) }
+func (p *PageInput) heightCursor() (uint64, error)           { return HeightCursor(p.Cursor) }
@@ -0,0 +1,44 @@
+package rpc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageInput(t *testing.T) {
+	input := &BlocksInput{}
+	require.NoError(t, json.Unmarshal([]byte(`{"minHeight":1,"cursor":"12","limit":5000,"order":"asc"}`), input))
+	assert.Equal(t, uint64(1), input.MinHeight)
+
+	page, err := input.page(true, MaxPageLimit)
+	require.NoError(t, err)
+	assert.Equal(t, MaxPageLimit, page.Limit)
+	assert.False(t, page.Reverse)
+	height, err := input.heightCursor()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(12), height)
+
+	page, err = (&PageInput{}).page(true, MaxPageLimit)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultPageLimit, page.Limit)
+	assert.True(t, page.Reverse)
+
+	page, err = (&PageInput{Limit: 200}).page(true, 50)
+	require.NoError(t, err)
+	assert.Equal(t, 50, page.Limit)
+
+	_, err = (&PageInput{Limit: -1}).page(false, MaxPageLimit)
+	assert.Error(t, err)
+	_, err = (&PageInput{Order: "random"}).page(false, MaxPageLimit)
+	assert.Error(t, err)
+	_, err = (&PageInput{Cursor: "abc"}).heightCursor()
+	assert.Error(t, err)
+	_, err = (&PageInput{Cursor: "abc"}).addressCursor()
+	assert.Error(t, err)
+	addr, err := (&PageInput{}).addressCursor()
+	assert.NoError(t, err)
+	assert.Nil(t, addr)
+}
@@ -4,12 +4,50 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"sync"
 
+	"github.com/gallactic/gallactic/common/binary"
 	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/state"
+	"github.com/gallactic/gallactic/crypto"
 )
 
-func NewAccountFilterFactory() *FilterFactory {
+// MaxScannedAccounts is the maximum number of the accounts which a listing scans. A filter which rarely
+// matches, like 'storage', stops there with a page which is not full and the cursor of the next account.
+const MaxScannedAccounts = 10000
+
+// ScanAccounts returns a page of the accounts which match, starting from the address, and
+// the cursor of the next account if the scan is not finished.
+func ScanAccounts(st *state.State, from *crypto.Address, page Page, match func(*account.Account) bool) ([]*account.Account, string) {
+	return scanAccounts(st, from, page, match, MaxScannedAccounts)
+}
+
+func scanAccounts(st *state.State, from *crypto.Address, page Page, match func(*account.Account) bool,
+	maxScanned int) ([]*account.Account, string) {
+
+	accounts := make([]*account.Account, 0)
+	nextCursor := ""
+	scanned := 0
+	st.IterateAccountsFrom(from, page.Reverse, func(acc *account.Account) (stop bool) {
+		if acc == nil {
+			return false
+		}
+		if len(accounts) == page.Limit || scanned == maxScanned {
+			nextCursor = acc.Address().String()
+			return true
+		}
+		scanned++
+		if match(acc) {
+			accounts = append(accounts, acc)
+		}
+		return false
+	})
+	return accounts, nextCursor
+}
+
+// Creates the filter factory for accounts. The state is used by the 'storage' filter.
+func NewAccountFilterFactory(st *state.State) *FilterFactory {
 	filterFactory := NewFilterFactory()
 
 	filterFactory.RegisterFilterPool("code", &sync.Pool{
@@ -24,6 +62,36 @@ func NewAccountFilterFactory() *FilterFactory {
 		},
 	})
 
+	filterFactory.RegisterFilterPool("sequence", &sync.Pool{
+		New: func() interface{} {
+			return &AccountSequenceFilter{}
+		},
+	})
+
+	filterFactory.RegisterFilterPool("permissions", &sync.Pool{
+		New: func() interface{} {
+			return &AccountPermissionsFilter{}
+		},
+	})
+
+	filterFactory.RegisterFilterPool("type", &sync.Pool{
+		New: func() interface{} {
+			return &AccountTypeFilter{}
+		},
+	})
+
+	filterFactory.RegisterFilterPool("address", &sync.Pool{
+		New: func() interface{} {
+			return &AccountAddressFilter{}
+		},
+	})
+
+	filterFactory.RegisterFilterPool("storage", &sync.Pool{
+		New: func() interface{} {
+			return &AccountStorageFilter{state: st}
+		},
+	})
+
 	return filterFactory
 }
 
@@ -97,3 +165,193 @@ func (abf *AccountBalanceFilter) Match(v interface{}) bool {
 	}
 	return abf.match(acc.Balance(), abf.value)
 }
+
+// Filter for account sequence.
+// Ops: All
+type AccountSequenceFilter struct {
+	op    string
+	value uint64
+	match func(uint64, uint64) bool
+}
+
+func (asf *AccountSequenceFilter) Configure(fd *FilterData) error {
+	val, err := ParseNumberValue(fd.Value)
+	if err != nil {
+		return err
+	}
+	match, err := GetRangeFilter(fd.Op, "sequence")
+	if err != nil {
+		return err
+	}
+	asf.match = match
+	asf.op = fd.Op
+	asf.value = val
+	return nil
+}
+
+func (asf *AccountSequenceFilter) Match(v interface{}) bool {
+	acc, ok := v.(*account.Account)
+	if !ok {
+		return false
+	}
+	return asf.match(acc.Sequence(), asf.value)
+}
+
+// Filter for account permissions. The value is a bitmask in hex, e.g. 0x20.
+// Ops: has (all the permissions are set), lacks (none of the permissions is set), == or !=
+type AccountPermissionsFilter struct {
+	op    string
+	value account.Permissions
+	match func(account.Permissions, account.Permissions) bool
+}
+
+func (apf *AccountPermissionsFilter) Configure(fd *FilterData) error {
+	var val account.Permissions
+	if err := val.UnmarshalText([]byte(fd.Value)); err != nil {
+		return fmt.Errorf("Wrong value type.")
+	}
+	switch fd.Op {
+	case "has":
+		apf.match = func(a, b account.Permissions) bool {
+			return a.IsSet(b)
+		}
+	case "lacks":
+		apf.match = func(a, b account.Permissions) bool {
+			return a&b == 0
+		}
+	case "==":
+		apf.match = func(a, b account.Permissions) bool {
+			return a == b
+		}
+	case "!=":
+		apf.match = func(a, b account.Permissions) bool {
+			return a != b
+		}
+	default:
+		return fmt.Errorf("Op: " + fd.Op + " is not supported for 'permissions' filtering")
+	}
+	apf.op = fd.Op
+	apf.value = val
+	return nil
+}
+
+func (apf *AccountPermissionsFilter) Match(v interface{}) bool {
+	acc, ok := v.(*account.Account)
+	if !ok {
+		return false
+	}
+	return apf.match(acc.Permissions(), apf.value)
+}
+
+// Filter for account type by the address prefix. The value is 'account' or 'contract'.
+// Ops: == or !=
+type AccountTypeFilter struct {
+	op       string
+	contract bool
+	match    func(bool, bool) bool
+}
+
+func (atf *AccountTypeFilter) Configure(fd *FilterData) error {
+	switch strings.ToLower(fd.Value) {
+	case "account":
+		atf.contract = false
+	case "contract":
+		atf.contract = true
+	default:
+		return fmt.Errorf("Wrong value type. It should be 'account' or 'contract'")
+	}
+	match, err := GetBoolFilter(fd.Op, "type")
+	if err != nil {
+		return err
+	}
+	atf.match = match
+	atf.op = fd.Op
+	return nil
+}
+
+func (atf *AccountTypeFilter) Match(v interface{}) bool {
+	acc, ok := v.(*account.Account)
+	if !ok {
+		return false
+	}
+	addr := acc.Address()
+	return atf.match(addr.IsContractAddress(), atf.contract)
+}
+
+// Filter for account address.
+// Ops: prefix, == or !=
+type AccountAddressFilter struct {
+	op    string
+	value string
+	match func(string, string) bool
+}
+
+func (aaf *AccountAddressFilter) Configure(fd *FilterData) error {
+	switch fd.Op {
+	case "prefix":
+		aaf.match = strings.HasPrefix
+	case "==":
+		aaf.match = func(a, b string) bool {
+			return a == b
+		}
+	case "!=":
+		aaf.match = func(a, b string) bool {
+			return a != b
+		}
+	default:
+		return fmt.Errorf("Op: " + fd.Op + " is not supported for 'address' filtering")
+	}
+	aaf.op = fd.Op
+	aaf.value = fd.Value
+	return nil
+}
+
+func (aaf *AccountAddressFilter) Match(v interface{}) bool {
+	acc, ok := v.(*account.Account)
+	if !ok {
+		return false
+	}
+	return aaf.match(acc.Address().String(), aaf.value)
+}
+
+// Filter for the existence of the contract storage. The value is 'true' or 'false'.
+// Ops: == or !=
+type AccountStorageFilter struct {
+	state *state.State
+	op    string
+	value bool
+	match func(bool, bool) bool
+}
+
+func (asf *AccountStorageFilter) Configure(fd *FilterData) error {
+	switch strings.ToLower(fd.Value) {
+	case "true":
+		asf.value = true
+	case "false":
+		asf.value = false
+	default:
+		return fmt.Errorf("Wrong value type. It should be 'true' or 'false'")
+	}
+	match, err := GetBoolFilter(fd.Op, "storage")
+	if err != nil {
+		return err
+	}
+	asf.match = match
+	asf.op = fd.Op
+	return nil
+}
+
+func (asf *AccountStorageFilter) Match(v interface{}) bool {
+	acc, ok := v.(*account.Account)
+	if !ok {
+		return false
+	}
+	hasStorage := false
+	addr := acc.Address()
+	if asf.state != nil && addr.IsContractAddress() {
+		hasStorage, _ = asf.state.IterateStorage(addr, func(key, value binary.Word256) (stop bool) {
+			return true
+		})
+	}
+	return asf.match(hasStorage, asf.value)
+}
@@ -0,0 +1,119 @@
+package rpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gallactic/gallactic/common/binary"
+	"github.com/gallactic/gallactic/core/account"
+	"github.com/gallactic/gallactic/core/account/permission"
+	"github.com/gallactic/gallactic/core/state"
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func TestAccountFilters(t *testing.T) {
+	st := state.NewState(dbm.NewMemDB())
+	cache := state.NewCache(st)
+
+	acc1 := account.NewAccountFromSecret("secret1")
+	acc1.AddToBalance(1000)
+	acc1.SetPermissions(permission.Send | permission.Bond)
+	acc2 := account.NewAccountFromSecret("secret2")
+	acc2.AddToBalance(10)
+	acc2.SetPermissions(permission.Bond)
+	acc2.IncSequence()
+	contract1, _ := account.NewContractAccount(crypto.DeriveContractAddress(acc1.Address(), 1))
+	contract1.AddToBalance(5000)
+	contract2, _ := account.NewContractAccount(crypto.DeriveContractAddress(acc1.Address(), 2))
+	for _, acc := range []*account.Account{acc1, acc2, contract1, contract2} {
+		require.NoError(t, cache.UpdateAccount(acc))
+	}
+	require.NoError(t, cache.SetStorage(contract1.Address(), binary.Uint64ToWord256(1), binary.Uint64ToWord256(1)))
+	require.NoError(t, cache.Flush(nil))
+
+	factory := NewAccountFilterFactory(st)
+	match := func(fds ...*FilterData) []*account.Account {
+		filter, err := factory.NewFilter(fds)
+		require.NoError(t, err)
+		var list []*account.Account
+		for _, acc := range []*account.Account{acc1, acc2, contract1, contract2} {
+			if filter.Match(acc) {
+				list = append(list, acc)
+			}
+		}
+		return list
+	}
+
+	// Non-contract accounts with Bond permission and balance > 100
+	assert.Equal(t, []*account.Account{acc1}, match(
+		&FilterData{Field: "type", Op: "==", Value: "account"},
+		&FilterData{Field: "permissions", Op: "has", Value: "0x20"},
+		&FilterData{Field: "balance", Op: ">", Value: "100"},
+	))
+	assert.Equal(t, []*account.Account{contract1, contract2}, match(&FilterData{Field: "permissions", Op: "lacks", Value: "0x20"}))
+	assert.Equal(t, []*account.Account{acc2}, match(&FilterData{Field: "sequence", Op: ">=", Value: "1"}))
+	assert.Equal(t, []*account.Account{contract1}, match(&FilterData{Field: "storage", Op: "==", Value: "true"}))
+	assert.Equal(t, []*account.Account{acc1, acc2, contract2}, match(&FilterData{Field: "storage", Op: "!=", Value: "true"}))
+	assert.Equal(t, []*account.Account{contract1, contract2}, match(&FilterData{Field: "address", Op: "prefix", Value: "ct"}))
+	assert.Equal(t, []*account.Account{acc2}, match(&FilterData{Field: "address", Op: "==", Value: acc2.Address().String()}))
+
+	// Contracts with storage or accounts with balance < 100
+	assert.Equal(t, []*account.Account{acc2, contract1}, match(&FilterData{Or: [][]*FilterData{
+		{{Field: "type", Op: "==", Value: "contract"}, {Field: "storage", Op: "==", Value: "true"}},
+		{{Field: "type", Op: "==", Value: "account"}, {Field: "balance", Op: "<", Value: "100"}},
+	}}))
+
+	for _, fd := range []*FilterData{
+		{Field: "permissions", Op: ">", Value: "0x20"},
+		{Field: "permissions", Op: "has", Value: "bond"},
+		{Field: "type", Op: "==", Value: "validator"},
+		{Field: "storage", Op: "==", Value: "yes"},
+		{Field: "address", Op: ">", Value: "ac"},
+		{Field: "sequence", Op: "has", Value: "1"},
+		{Or: [][]*FilterData{{{Field: "unknown", Op: "==", Value: "1"}}}},
+	} {
+		_, err := factory.NewFilter([]*FilterData{fd})
+		assert.Error(t, err)
+	}
+}
+
+func TestScanAccounts(t *testing.T) {
+	st := state.NewState(dbm.NewMemDB())
+	cache := state.NewCache(st)
+	for i := 0; i < 5; i++ {
+		acc := account.NewAccountFromSecret(fmt.Sprintf("secret%d", i))
+		require.NoError(t, cache.UpdateAccount(acc))
+	}
+	require.NoError(t, cache.Flush(nil))
+
+	var all []*account.Account
+	st.IterateAccounts(func(acc *account.Account) (stop bool) {
+		all = append(all, acc)
+		return false
+	})
+	require.Equal(t, 5, len(all))
+	last := all[4].Address()
+	match := func(acc *account.Account) bool { return acc.Address() == last }
+
+	// The scan stops after 2 accounts with a cursor, even if the page is empty
+	accounts, next := scanAccounts(st, nil, Page{Limit: 10}, match, 2)
+	assert.Empty(t, accounts)
+	assert.Equal(t, all[2].Address().String(), next)
+	from, err := AddressCursor(next)
+	require.NoError(t, err)
+	accounts, next = scanAccounts(st, from, Page{Limit: 10}, match, 2)
+	assert.Empty(t, accounts)
+	from, err = AddressCursor(next)
+	require.NoError(t, err)
+	accounts, next = scanAccounts(st, from, Page{Limit: 10}, match, 2)
+	assert.Equal(t, []*account.Account{all[4]}, accounts)
+	assert.Equal(t, "", next)
+
+	// The page is full before the cap
+	accounts, next = scanAccounts(st, nil, Page{Limit: 2}, func(*account.Account) bool { return true }, 10)
+	assert.Equal(t, all[:2], accounts)
+	assert.Equal(t, all[2].Address().String(), next)
+}
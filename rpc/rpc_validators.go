@@ -0,0 +1,28 @@
+package rpc
+
+import (
+	"fmt"
+
+	"github.com/gallactic/gallactic/core/validator"
+	"github.com/gallactic/gallactic/crypto"
+)
+
+// ValidatorStatusFilter returns a predicate which matches the validators by status:
+// "active" for the validators in the active set, "inactive" for the bonded validators
+// which are not in the set, or empty for all the validators.
+func ValidatorStatusFilter(status string, activeSet []crypto.Address) (func(*validator.Validator) bool, error) {
+	active := make(map[crypto.Address]bool)
+	for _, addr := range activeSet {
+		active[addr] = true
+	}
+	switch status {
+	case "":
+		return func(*validator.Validator) bool { return true }, nil
+	case "active":
+		return func(val *validator.Validator) bool { return active[val.Address()] }, nil
+	case "inactive":
+		return func(val *validator.Validator) bool { return !active[val.Address()] }, nil
+	default:
+		return nil, fmt.Errorf("Invalid status '%s'. It should be 'active' or 'inactive'", status)
+	}
+}
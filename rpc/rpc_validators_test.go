@@ -0,0 +1,36 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/gallactic/gallactic/core/validator"
+	"github.com/gallactic/gallactic/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorStatusFilter(t *testing.T) {
+	pb1, _ := crypto.GenerateKey(nil)
+	pb2, _ := crypto.GenerateKey(nil)
+	val1, _ := validator.NewValidator(pb1, 0)
+	val2, _ := validator.NewValidator(pb2, 0)
+	set := []crypto.Address{val1.Address()}
+
+	match, err := ValidatorStatusFilter("active", set)
+	require.NoError(t, err)
+	assert.True(t, match(val1))
+	assert.False(t, match(val2))
+
+	match, err = ValidatorStatusFilter("inactive", set)
+	require.NoError(t, err)
+	assert.False(t, match(val1))
+	assert.True(t, match(val2))
+
+	match, err = ValidatorStatusFilter("", set)
+	require.NoError(t, err)
+	assert.True(t, match(val1))
+	assert.True(t, match(val2))
+
+	_, err = ValidatorStatusFilter("unbonding", set)
+	assert.Error(t, err)
+}
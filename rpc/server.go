@@ -22,9 +22,10 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/gallactic/gallactic/rpc/auth"
 	rpcConf "github.com/gallactic/gallactic/rpc/config"
+	"github.com/gallactic/gallactic/rpc/limit"
 	"github.com/gin-gonic/gin"
-	log "github.com/inconshreveable/log15"
 	cors "github.com/tommy351/gin-cors"
 	graceful "gopkg.in/tylerb/graceful.v1"
 )
@@ -44,16 +45,17 @@ type Server interface {
 	Shutdown(ctx context.Context) error
 }
 
-// The ServeProcess wraps all the Servers. Starting it will
-// add all the server handlers to the router and start listening
-// for incoming requests. There is also startup and shutdown events
-// that can be listened to, on top of any events that the servers
-// may have (the default websocket server has events for monitoring
-// sessions. Startup event listeners should be added before calling
-// 'Start()'. Stop event listeners can be added up to the point where
-// the server is stopped and the event is fired.
+// The ServeProcess wraps all the Servers, e.g. the JSON-RPC and the
+// WebSocket servers. Starting it will add all the server handlers to
+// the router and start listening for incoming requests. There is also
+// startup and shutdown events that can be listened to. Startup event
+// listeners should be added before calling 'Start()'. Stop event
+// listeners can be added up to the point where the server is stopped
+// and the event is fired.
 type ServeProcess struct {
 	config           *rpcConf.ServerConfig
+	authenticator    *auth.Authenticator
+	limiter          *limit.Limiter
 	servers          []Server
 	stopChan         chan struct{}
 	startListenChans []chan struct{}
@@ -68,7 +70,8 @@ func (serveProcess *ServeProcess) Start() error {
 	config := serveProcess.config
 
 	ch := NewCORSMiddleware(config.CORS)
-	router.Use(gin.Recovery(), logHandler(), contentTypeMW, ch)
+	router.Use(gin.Recovery(), logHandler(), contentTypeMW, ch, clientMW(serveProcess.limiter),
+		authMW(serveProcess.authenticator))
 
 	address := config.Bind.Address
 	port := config.Bind.Port
@@ -119,7 +122,7 @@ func (serveProcess *ServeProcess) Start() error {
 		lst = l
 	}
 	serveProcess.srv = srv
-	log.Info("RPC server started.",
+	logger.Info("RPC server started.",
 		"address", serveProcess.config.Bind.Address,
 		"port", serveProcess.config.Bind.Port)
 	for _, c := range serveProcess.startListenChans {
@@ -137,14 +140,14 @@ func (serveProcess *ServeProcess) Start() error {
 	// calls 'Stop' on the process.
 	go func() {
 		<-serveProcess.stopChan
-		log.Info("Close signal sent to RPC server.")
+		logger.Info("Close signal sent to RPC server.")
 		serveProcess.srv.Stop(killTime)
 	}()
 	// Listen to the servers stop event. It is triggered when
 	// the server has been fully shut down.
 	go func() {
 		<-serveProcess.srv.StopChan()
-		log.Info("RPC server stop event fired. Good bye.")
+		logger.Info("RPC server stop event fired. Good bye.")
 		for _, c := range serveProcess.stopListenChans {
 			c <- struct{}{}
 		}
@@ -195,8 +198,9 @@ func (serveProcess *ServeProcess) StopEventChannel() <-chan struct{} {
 	return lChan
 }
 
-// Creates a new serve process.
-func NewServeProcess(config *rpcConf.ServerConfig,
+// Creates a new serve process. The authenticator keeps the access levels of the callers,
+// and the limiter resolves their IP addresses behind the trusted proxies.
+func NewServeProcess(config *rpcConf.ServerConfig, authenticator *auth.Authenticator, limiter *limit.Limiter,
 	servers ...Server) (*ServeProcess, error) {
 	var scfg rpcConf.ServerConfig
 	if config == nil {
@@ -209,6 +213,8 @@ func NewServeProcess(config *rpcConf.ServerConfig,
 	stopListeners := make([]chan struct{}, 0)
 	sp := &ServeProcess{
 		config:           &scfg,
+		authenticator:    authenticator,
+		limiter:          limiter,
 		servers:          servers,
 		stopChan:         stopChan,
 		startListenChans: startListeners,
@@ -220,7 +226,6 @@ func NewServeProcess(config *rpcConf.ServerConfig,
 
 // Used to enable log15 logging instead of the default Gin logging.
 func logHandler() gin.HandlerFunc {
-	logger := log.New("module", "RPC")
 	return func(c *gin.Context) {
 
 		path := c.Request.URL.Path
@@ -228,12 +233,13 @@ func logHandler() gin.HandlerFunc {
 		// Process request
 		c.Next()
 
-		clientIP := c.ClientIP()
+		clientIP := ClientIP(c.Request.Context())
 		method := c.Request.Method
 		statusCode := c.Writer.Status()
 		comment := c.Errors.String()
 
-		logger.Info("client_ip", clientIP,
+		logger.Info("Request handled",
+			"client_ip", clientIP,
 			"status_code", statusCode,
 			"method", method,
 			"path", path,
@@ -261,3 +267,45 @@ func contentTypeMW(c *gin.Context) {
 		c.Next()
 	}
 }
+
+type (
+	accessLevelKey struct{}
+	clientIPKey    struct{}
+)
+
+// AccessLevel returns the access level of the caller which is set by the authentication middleware
+func AccessLevel(ctx context.Context) auth.Level {
+	level, _ := ctx.Value(accessLevelKey{}).(auth.Level)
+	return level
+}
+
+// ClientIP returns the IP address of the caller which is set by the client middleware
+func ClientIP(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey{}).(string)
+	return ip
+}
+
+// clientMW keeps the IP address of the caller in the request context. The forwarded headers are
+// used only if the caller is a trusted proxy of the limiter.
+func clientMW(limiter *limit.Limiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ip := limiter.ClientIP(c.Request.RemoteAddr, c.GetHeader("X-Forwarded-For"))
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), clientIPKey{}, ip))
+		c.Next()
+	}
+}
+
+// authMW authenticates the caller by the `X-API-Key` or the `Authorization: Bearer` header and
+// keeps its access level in the request context. The requests with invalid credentials are rejected.
+func authMW(authenticator *auth.Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := auth.TokenFromHeaders(c.GetHeader("X-API-Key"), c.GetHeader("Authorization"))
+		level, err := authenticator.Authenticate(token)
+		if err != nil {
+			c.AbortWithError(http.StatusUnauthorized, err)
+			return
+		}
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), accessLevelKey{}, level))
+		c.Next()
+	}
+}
@@ -19,43 +19,49 @@ import (
 
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/gallactic/gallactic/common/binary"
 	"github.com/gallactic/gallactic/core/account"
 	"github.com/gallactic/gallactic/core/blockchain"
 	"github.com/gallactic/gallactic/core/consensus/tendermint/query"
+	"github.com/gallactic/gallactic/core/events"
 	"github.com/gallactic/gallactic/core/execution"
+	"github.com/gallactic/gallactic/core/indexer"
 	"github.com/gallactic/gallactic/core/state"
 	"github.com/gallactic/gallactic/core/validator"
 	"github.com/gallactic/gallactic/crypto"
 	"github.com/gallactic/gallactic/txs"
 	"github.com/gallactic/gallactic/version"
+	tmPubSub "github.com/tendermint/tendermint/libs/pubsub"
 	tmTypes "github.com/tendermint/tendermint/types"
 )
 
-// Magic! Should probably be configurable, but not shouldn't be so huge we
-// end up DoSing ourselves.
-const MaxBlockLookback = 1000
-
 // Base service that provides implementation for all underlying RPC methods
 type Service struct {
-	ctx        context.Context
-	state      *state.State
-	blockchain *blockchain.Blockchain
-	transactor *execution.Transactor
-	nodeView   *query.NodeView
+	ctx             context.Context
+	state           *state.State
+	blockchain      *blockchain.Blockchain
+	transactor      *execution.Transactor
+	mempoolAccounts execution.MempoolAccounts
+	eventBus        events.EventBus
+	txIndexer       *indexer.TxIndexer
+	nodeView        *query.NodeView
 }
 
-func NewService(ctx context.Context, blockchain *blockchain.Blockchain,
-	transactor *execution.Transactor, nView *query.NodeView) *Service {
+func NewService(ctx context.Context, blockchain *blockchain.Blockchain, transactor *execution.Transactor,
+	mempoolAccounts execution.MempoolAccounts, eventBus events.EventBus, txIndexer *indexer.TxIndexer, nView *query.NodeView) *Service {
 
 	return &Service{
-		ctx:        ctx,
-		state:      blockchain.State(),
-		blockchain: blockchain,
-		transactor: transactor,
-		nodeView:   nView,
+		ctx:             ctx,
+		state:           blockchain.State(),
+		blockchain:      blockchain,
+		transactor:      transactor,
+		mempoolAccounts: mempoolAccounts,
+		eventBus:        eventBus,
+		txIndexer:       txIndexer,
+		nodeView:        nView,
 	}
 }
 
@@ -63,6 +69,11 @@ func (s *Service) Transactor() *execution.Transactor {
 	return s.transactor
 }
 
+// MempoolAccounts is the read-only view of the check cache
+func (s *Service) MempoolAccounts() execution.MempoolAccounts {
+	return s.mempoolAccounts
+}
+
 func (s *Service) State() *state.State {
 	return s.state
 }
@@ -103,6 +114,27 @@ func (s *Service) ListBlockTxs(height uint64) (*BlockTxsOutput, error) {
 		Txs:   txList,
 	}, nil
 }
+
+// ListAccountTxs returns a page of the committed transactions which touched the account between
+// fromHeight and toHeight. Zero toHeight means up to the last block.
+func (s *Service) ListAccountTxs(address crypto.Address, fromHeight, toHeight uint64,
+	from *indexer.Cursor, page Page) (*AccountTxsOutput, error) {
+
+	list, next, err := s.txIndexer.AccountTxs(address, fromHeight, toHeight, from, page.Limit, page.Reverse)
+	if err != nil {
+		return nil, err
+	}
+	nextCursor := ""
+	if next != nil {
+		nextCursor = next.String()
+	}
+	return &AccountTxsOutput{
+		Address:    address,
+		Txs:        list,
+		NextCursor: nextCursor,
+	}, nil
+}
+
 func (s *Service) Status() (*StatusOutput, error) {
 	latestHeight := s.blockchain.LastBlockHeight()
 	var (
@@ -130,6 +162,30 @@ func (s *Service) Status() (*StatusOutput, error) {
 	}, nil
 }
 
+// Health returns the sync status of the node and whether its validator is in the active set
+func (s *Service) Health() (*HealthOutput, error) {
+	publicKey, err := s.nodeView.PrivValidatorPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	isValidator := false
+	for _, addr := range s.blockchain.LastValidators() {
+		if addr == publicKey.ValidatorAddress() {
+			isValidator = true
+			break
+		}
+	}
+	lastBlockTime := s.blockchain.LastBlockTime()
+	return &HealthOutput{
+		CatchingUp:        s.nodeView.IsCatchingUp(),
+		Peers:             s.nodeView.Peers().Size(),
+		LatestBlockHeight: s.blockchain.LastBlockHeight(),
+		LatestBlockTime:   lastBlockTime,
+		LatestBlockAge:    time.Since(lastBlockTime).Seconds(),
+		IsValidator:       isValidator,
+	}, nil
+}
+
 func (s *Service) ChainIdentifiers() (*ChainIdOutput, error) {
 	return &ChainIdOutput{
 		ChainName:   s.blockchain.Genesis().ChainName(),
@@ -175,18 +231,24 @@ func (s *Service) GetAccount(address crypto.Address) (*AccountOutput, error) {
 	return &AccountOutput{Account: acc}, nil
 }
 
-func (s *Service) ListAccounts(predicate func(*account.Account) bool) (*AccountsOutput, error) {
-	accounts := make([]*account.Account, 0)
-	s.state.IterateAccounts(func(acc *account.Account) (stop bool) {
-		if predicate(acc) {
-			accounts = append(accounts, acc)
-		}
-		return false
-	})
+// GetMempoolAccount returns the account with the changes of the transactions in the mempool,
+// like the pending balance and sequence
+func (s *Service) GetMempoolAccount(address crypto.Address) (*AccountOutput, error) {
+	acc, err := s.mempoolAccounts.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountOutput{Account: acc}, nil
+}
 
+// ListAccounts returns a page of the accounts which match the predicate, ordered by address
+// and starting from the given address. NextCursor is set if there are more accounts.
+func (s *Service) ListAccounts(predicate func(*account.Account) bool, from *crypto.Address, page Page) (*AccountsOutput, error) {
+	accounts, nextCursor := ScanAccounts(s.state, from, page, predicate)
 	return &AccountsOutput{
 		BlockHeight: s.blockchain.LastBlockHeight(),
 		Accounts:    accounts,
+		NextCursor:  nextCursor,
 	}, nil
 }
 
@@ -228,12 +290,12 @@ func (s *Service) GetBlock(height uint64) (*BlockOutput, error) {
 	}, nil
 }
 
-// Returns the current blockchain height and metadata for a range of blocks
-// between minHeight and maxHeight. Only returns maxBlockLookback block metadata
-// from the top of the range of blocks.
+// Returns the current blockchain height and a page of metadata for a range of blocks
+// between minHeight and maxHeight. Blocks are listed from the top of the range unless
+// the page is in ascending order. A non-zero from height is the first block of the page.
 // Passing 0 for maxHeight sets the upper height of the range to the current
 // blockchain height.
-func (s *Service) ListBlocks(minHeight, maxHeight uint64) (*BlocksOutput, error) {
+func (s *Service) ListBlocks(minHeight, maxHeight, from uint64, page Page) (*BlocksOutput, error) {
 	latestHeight := s.blockchain.LastBlockHeight()
 
 	if minHeight == 0 {
@@ -242,32 +304,86 @@ func (s *Service) ListBlocks(minHeight, maxHeight uint64) (*BlocksOutput, error)
 	if maxHeight == 0 || latestHeight < maxHeight {
 		maxHeight = latestHeight
 	}
-	if maxHeight > minHeight && maxHeight-minHeight > MaxBlockLookback {
-		minHeight = maxHeight - MaxBlockLookback
-	}
 
-	var blockMetas []*tmTypes.BlockMeta
-	for height := maxHeight; height >= minHeight; height-- {
-		blockMeta := s.nodeView.BlockStore().LoadBlockMeta(int64(height))
-		blockMetas = append(blockMetas, blockMeta)
+	blockMetas := make([]*tmTypes.BlockMeta, 0)
+	nextCursor := ""
+	if page.Reverse {
+		if from != 0 && from < maxHeight {
+			maxHeight = from
+		}
+		height := maxHeight
+		for ; height >= minHeight && len(blockMetas) < page.Limit; height-- {
+			blockMetas = append(blockMetas, s.nodeView.BlockStore().LoadBlockMeta(int64(height)))
+		}
+		if height >= minHeight {
+			nextCursor = strconv.FormatUint(height, 10)
+		}
+	} else {
+		if from > minHeight {
+			minHeight = from
+		}
+		height := minHeight
+		for ; height <= maxHeight && len(blockMetas) < page.Limit; height++ {
+			blockMetas = append(blockMetas, s.nodeView.BlockStore().LoadBlockMeta(int64(height)))
+		}
+		if height <= maxHeight {
+			nextCursor = strconv.FormatUint(height, 10)
+		}
 	}
 
 	return &BlocksOutput{
 		LastHeight: latestHeight,
 		BlockMetas: blockMetas,
+		NextCursor: nextCursor,
 	}, nil
 }
 
-func (s *Service) ListValidators() (*ValidatorsOutput, error) {
+// ListValidators returns a page of the validators which match the predicate, ordered by address
+// and starting from the given address. NextCursor is set if there are more validators.
+func (s *Service) ListValidators(predicate func(*validator.Validator) bool, from *crypto.Address, page Page) (*ValidatorsOutput, error) {
 	validators := make([]*validator.Validator, 0)
-	s.blockchain.State().IterateValidators(func(val *validator.Validator) (stop bool) {
+	nextCursor := ""
+	s.state.IterateValidatorsFrom(from, page.Reverse, func(val *validator.Validator) (stop bool) {
+		if !predicate(val) {
+			return false
+		}
+		if len(validators) == page.Limit {
+			nextCursor = val.Address().String()
+			return true
+		}
 		validators = append(validators, val)
-		return
+		return false
 	})
 	return &ValidatorsOutput{
 		BlockHeight:         s.blockchain.LastBlockHeight(),
 		BondedValidators:    validators,
 		UnbondingValidators: nil,
+		NextCursor:          nextCursor,
+	}, nil
+}
+
+// GetValidatorSet returns the validator set at the end of the block at the height
+func (s *Service) GetValidatorSet(height uint64) (*ValidatorSetOutput, error) {
+	rec, err := s.blockchain.ValidatorSetAt(height)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetOutput{
+		Height:     rec.Height,
+		Validators: rec.Validators,
+		Leavers:    rec.Leavers,
+	}, nil
+}
+
+// GetValidatorHistory returns the heights which the validator joined or left the validator set
+func (s *Service) GetValidatorHistory(address crypto.Address) (*ValidatorHistoryOutput, error) {
+	events, err := s.blockchain.ValidatorHistory(address)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorHistoryOutput{
+		Address: address,
+		Events:  events,
 	}, nil
 }
 
@@ -311,3 +427,12 @@ func (s *Service) LastBlockInfo(blockWithin string) (*LastBlockInfoOutput, error
 	return nil, fmt.Errorf("no block committed within the last %s (cutoff: %s), last block info: %s",
 		blockWithin, blockTimeThreshold.Format(time.RFC3339), string(resJSON))
 }
+
+// Subscribe calls handle with the events which match the query. handle should never block, see events.SubscribeFunc.
+func (s *Service) Subscribe(ctx context.Context, subID string, query tmPubSub.Query, handle func(msg interface{})) error {
+	return events.SubscribeFunc(ctx, s.eventBus, subID, query, handle)
+}
+
+func (s *Service) Unsubscribe(ctx context.Context, subID string) error {
+	return s.eventBus.UnsubscribeAll(ctx, subID)
+}
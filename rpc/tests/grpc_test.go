@@ -50,12 +50,12 @@ func TestBlockchainMethods(t *testing.T) {
 	require.Equal(t, ret2.Validator.Address, valAddr.String())
 
 	//
-	ret3, err := client.GetAccounts(context.Background(), &pb.Empty{})
+	ret3, err := client.GetAccounts(context.Background(), &pb.AccountsRequest{})
 	require.NoError(t, err)
 	require.Equal(t, ret3.Accounts[0].Account, tGenesis.Accounts()[1])
 
 	//
-	ret4, err := client.GetValidators(context.Background(), &pb.Empty{})
+	ret4, err := client.GetValidators(context.Background(), &pb.ValidatorsRequest{})
 	require.NoError(t, err)
 	require.Equal(t, ret4.Validators[0].Address, valAddr.String())
 
@@ -0,0 +1,324 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gallactic/gallactic/core/events"
+	rpcConfig "github.com/gallactic/gallactic/rpc/config"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	tmPubSub "github.com/tendermint/tendermint/libs/pubsub"
+)
+
+const (
+	// Time allowed to write a message to the peer
+	writeWait = 10 * time.Second
+	// Time allowed to read the next pong message from the peer
+	pongWait = 60 * time.Second
+	// Send pings to peer with this period. Must be less than pongWait
+	pingPeriod = (pongWait * 9) / 10
+	// Maximum size of a message from the peer
+	maxMessageSize = 1024 * 1024
+	// Number of the messages buffered for a session. The session is closed if the peer is too slow
+	sendBufferSize = 256
+)
+
+// Server used to handle JSON-RPC 2.0 requests and subscriptions over WebSocket. Implements server.Server
+type WebSocketServer struct {
+	service          *JSONService
+	upgrader         websocket.Upgrader
+	maxSessions      int
+	maxSubscriptions int
+	lk               sync.Mutex
+	sessions         map[*WebSocketSession]struct{}
+	running          bool
+}
+
+// Create a new WebSocketServer
+func NewWebSocketServer(service *JSONService) *WebSocketServer {
+	return &WebSocketServer{
+		service:  service,
+		sessions: make(map[*WebSocketSession]struct{}),
+	}
+}
+
+// Start adds the WebSocket path to the router.
+func (wss *WebSocketServer) Start(config *rpcConfig.ServerConfig, router *gin.Engine) {
+	if !config.WebSocket.Enable {
+		return
+	}
+	wss.maxSessions = config.WebSocket.MaxSessions
+	wss.maxSubscriptions = config.WebSocket.MaxSubscriptions
+	wss.upgrader = websocket.Upgrader{
+		CheckOrigin: checkOrigin(config.CORS.AllowOrigins),
+	}
+	router.GET(config.WebSocket.Endpoint, wss.handleFunc)
+	wss.running = true
+}
+
+// Is the server currently running?
+func (wss *WebSocketServer) Running() bool {
+	return wss.running
+}
+
+// Shut the server down. All the sessions are closed.
+func (wss *WebSocketServer) Shutdown(ctx context.Context) error {
+	wss.lk.Lock()
+	sessions := make([]*WebSocketSession, 0, len(wss.sessions))
+	for session := range wss.sessions {
+		sessions = append(sessions, session)
+	}
+	wss.lk.Unlock()
+
+	for _, session := range sessions {
+		session.Close()
+	}
+	wss.running = false
+	return nil
+}
+
+func (wss *WebSocketServer) handleFunc(c *gin.Context) {
+	wss.lk.Lock()
+	full := wss.maxSessions > 0 && len(wss.sessions) >= wss.maxSessions
+	wss.lk.Unlock()
+	if full {
+		c.AbortWithError(http.StatusServiceUnavailable, fmt.Errorf("Too many WebSocket sessions"))
+		return
+	}
+
+	conn, err := wss.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// The upgrader has already replied to the client
+		logger.Warn("Failed to upgrade to WebSocket", "error", err)
+		return
+	}
+
+	session := newWebSocketSession(c.Request.Context(), conn, wss.service, wss.maxSubscriptions)
+	wss.lk.Lock()
+	wss.sessions[session] = struct{}{}
+	wss.lk.Unlock()
+
+	logger.Debug("WebSocket session opened", "remote", conn.RemoteAddr())
+	go session.writeLoop()
+	session.readLoop()
+
+	wss.lk.Lock()
+	delete(wss.sessions, session)
+	wss.lk.Unlock()
+	logger.Debug("WebSocket session closed", "remote", conn.RemoteAddr())
+}
+
+// checkOrigin allows the requests without origin, from the same host or from the allowed origins
+func checkOrigin(allowOrigins []string) func(r *http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		u, err := url.Parse(origin)
+		if err == nil && u.Host == r.Host {
+			return true
+		}
+		for _, o := range allowOrigins {
+			if o == "*" || o == origin {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// subscriptionQuery returns the query of the events for the subscription
+func subscriptionQuery(input *SubscribeInput) (tmPubSub.Query, error) {
+	switch input.Event {
+	case "newBlock":
+		return events.QueryForNewBlock(), nil
+	case "tx":
+		if len(input.Hash) > 0 {
+			return events.QueryForTx(input.Hash), nil
+		}
+		if input.Address != nil {
+			return events.QueryForAccountTxs(*input.Address), nil
+		}
+		return nil, fmt.Errorf("Hash or address of the transaction should be set")
+	default:
+		return nil, fmt.Errorf("Invalid event '%s'. It should be 'newBlock' or 'tx'", input.Event)
+	}
+}
+
+// WebSocketSession is a connection to a WebSocket client. It is passed as the
+// requester to the JSON-RPC handlers.
+type WebSocketSession struct {
+	conn             *websocket.Conn
+	ctx              context.Context
+	service          *JSONService
+	maxSubscriptions int
+	send             chan []byte
+	quit             chan struct{}
+	closeOnce        sync.Once
+	lk               sync.Mutex
+	subscriptions    map[string]chan struct{}
+}
+
+// newWebSocketSession creates a session. The context of the upgrade request keeps the access level and the client of the session.
+func newWebSocketSession(ctx context.Context, conn *websocket.Conn, service *JSONService, maxSubscriptions int) *WebSocketSession {
+	return &WebSocketSession{
+		ctx:              ctx,
+		conn:             conn,
+		service:          service,
+		maxSubscriptions: maxSubscriptions,
+		send:             make(chan []byte, sendBufferSize),
+		quit:             make(chan struct{}),
+		subscriptions:    make(map[string]chan struct{}),
+	}
+}
+
+// Close closes the connection and removes all the subscriptions of the session
+func (s *WebSocketSession) Close() {
+	s.closeOnce.Do(func() {
+		close(s.quit)
+		s.conn.Close()
+
+		s.lk.Lock()
+		defer s.lk.Unlock()
+		for subID, done := range s.subscriptions {
+			close(done)
+			s.service.service.Unsubscribe(context.Background(), subID)
+		}
+		s.subscriptions = make(map[string]chan struct{})
+	})
+}
+
+// Subscribe subscribes the session to the events which match the query.
+// The events are pushed to the client as `gallactic.subscription` notifications.
+func (s *WebSocketSession) Subscribe(query tmPubSub.Query) (string, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if s.maxSubscriptions > 0 && len(s.subscriptions) >= s.maxSubscriptions {
+		return "", fmt.Errorf("Too many subscriptions. Maximum number of the subscriptions is %d", s.maxSubscriptions)
+	}
+
+	subID := events.GenSubID()
+	done := make(chan struct{})
+	if err := s.service.service.Subscribe(context.Background(), subID, query, func(msg interface{}) {
+		select {
+		case <-done:
+		case <-s.quit:
+		default:
+			s.notify(subID, msg)
+		}
+	}); err != nil {
+		return "", err
+	}
+	s.subscriptions[subID] = done
+
+	return subID, nil
+}
+
+// Unsubscribe removes the subscription. It returns false if there is no subscription with this id.
+func (s *WebSocketSession) Unsubscribe(subID string) (bool, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	done, ok := s.subscriptions[subID]
+	if !ok {
+		return false, nil
+	}
+	close(done)
+	delete(s.subscriptions, subID)
+
+	if err := s.service.service.Unsubscribe(context.Background(), subID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *WebSocketSession) notify(subID string, msg interface{}) {
+	notification := NewRPCNotification(SUBSCRIPTION, &SubscriptionOutput{
+		SubscriptionID: subID,
+		Result:         msg,
+	})
+	bs, err := s.service.codec.EncodeBytes(notification)
+	if err != nil {
+		logger.Error("Failed to encode the notification", "error", err)
+		return
+	}
+
+	select {
+	case s.send <- bs:
+	default:
+		logger.Warn("WebSocket client is too slow, closing the session", "remote", s.conn.RemoteAddr())
+		go s.Close()
+	}
+}
+
+func (s *WebSocketSession) readLoop() {
+	defer s.Close()
+
+	readLimit := int64(maxMessageSize)
+	if max := s.service.limiter.MaxRequestSize(); max > 0 && max < readLimit {
+		readLimit = max
+	}
+	s.conn.SetReadLimit(readLimit)
+	s.conn.SetReadDeadline(time.Now().Add(pongWait))
+	s.conn.SetPongHandler(func(string) error {
+		return s.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		_, data, err := s.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				logger.Warn("WebSocket read error", "error", err)
+			}
+			return
+		}
+
+		response := s.service.ProcessMessage(s.ctx, data, s)
+		if response == nil {
+			continue
+		}
+		bs, err := s.service.codec.EncodeBytes(response)
+		if err != nil {
+			logger.Error("Failed to encode the response", "error", err)
+			continue
+		}
+
+		select {
+		case s.send <- bs:
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+func (s *WebSocketSession) writeLoop() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		s.Close()
+	}()
+
+	for {
+		select {
+		case bs := <-s.send:
+			s.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := s.conn.WriteMessage(websocket.TextMessage, bs); err != nil {
+				return
+			}
+		case <-ticker.C:
+			s.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := s.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case <-s.quit:
+			return
+		}
+	}
+}
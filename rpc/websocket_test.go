@@ -0,0 +1,100 @@
+package rpc
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gallactic/gallactic/core/events"
+	"github.com/gallactic/gallactic/crypto"
+	rpcConfig "github.com/gallactic/gallactic/rpc/config"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startWebSocketServer(t *testing.T, eventBus events.EventBus) (*httptest.Server, *websocket.Conn) {
+	codec := NewTCodec()
+	service := &Service{eventBus: eventBus}
+	js := NewJSONService(codec, service, false, nil, nil)
+
+	gin.SetMode(gin.ReleaseMode)
+	router := gin.New()
+	NewWebSocketServer(js).Start(rpcConfig.DefaultServerConfig(), router)
+	srv := httptest.NewServer(router)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/ws", nil)
+	require.NoError(t, err)
+	return srv, conn
+}
+
+func call(t *testing.T, conn *websocket.Conn, msg string) map[string]interface{} {
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(msg)))
+	return read(t, conn)
+}
+
+func read(t *testing.T, conn *websocket.Conn) map[string]interface{} {
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	res := make(map[string]interface{})
+	require.NoError(t, conn.ReadJSON(&res))
+	return res
+}
+
+func TestWebSocketSubscription(t *testing.T) {
+	eventBus := events.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	defer eventBus.Stop()
+
+	srv, conn := startWebSocketServer(t, eventBus)
+	defer srv.Close()
+	defer conn.Close()
+
+	res := call(t, conn, `{"jsonrpc":"2.0","id":"1","method":"gallactic.subscribe","params":{"event":"newBlock"}}`)
+	blockSubID := res["result"].(map[string]interface{})["subscriptionId"].(string)
+
+	addr, err := crypto.AddressFromString("ac8KfZqAKYayEWsc6vuwfLu5GDBaCUvoH8B")
+	require.NoError(t, err)
+	res = call(t, conn, `{"jsonrpc":"2.0","id":"2","method":"gallactic.subscribe","params":{"event":"tx","address":"`+addr.String()+`"}}`)
+	txSubID := res["result"].(map[string]interface{})["subscriptionId"].(string)
+
+	res = call(t, conn, `{"jsonrpc":"2.0","id":"3","method":"gallactic.subscribe","params":{"event":"foo"}}`)
+	assert.NotNil(t, res["error"])
+
+	eventBus.Publish(&events.Block{Height: 7}, events.TagsForBlock())
+	res = read(t, conn)
+	assert.Equal(t, SUBSCRIPTION, res["method"])
+	params := res["params"].(map[string]interface{})
+	assert.Equal(t, blockSubID, params["subscriptionId"])
+	assert.Equal(t, float64(7), params["result"].(map[string]interface{})["height"])
+
+	// Not related to the address
+	eventBus.Publish("other", events.TagsForTx([]byte{1}, nil))
+	eventBus.Publish("receipt", events.TagsForTx([]byte{2}, []crypto.Address{addr}))
+	res = read(t, conn)
+	params = res["params"].(map[string]interface{})
+	assert.Equal(t, txSubID, params["subscriptionId"])
+	assert.Equal(t, "receipt", params["result"])
+
+	res = call(t, conn, `{"jsonrpc":"2.0","id":"4","method":"gallactic.unsubscribe","params":{"subscriptionId":"`+blockSubID+`"}}`)
+	assert.Equal(t, true, res["result"].(map[string]interface{})["result"])
+	res = call(t, conn, `{"jsonrpc":"2.0","id":"5","method":"gallactic.unsubscribe","params":{"subscriptionId":"`+blockSubID+`"}}`)
+	assert.Equal(t, false, res["result"].(map[string]interface{})["result"])
+
+	// Batch over WebSocket
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage,
+		[]byte(`[{"jsonrpc":"2.0","id":"6","method":"foo"},{"jsonrpc":"2.0","id":"7","method":"bar"}]`)))
+	var batch []map[string]interface{}
+	require.NoError(t, conn.ReadJSON(&batch))
+	assert.Equal(t, 2, len(batch))
+}
+
+func TestSubscribeOverHTTP(t *testing.T) {
+	js := NewJSONService(NewTCodec(), &Service{}, false, nil, nil)
+	res := js.ProcessMessage(context.Background(), []byte(`{"jsonrpc":"2.0","id":"1","method":"gallactic.subscribe","params":{"event":"newBlock"}}`), nil)
+	errRes, ok := res.(*RPCErrorResponse)
+	require.True(t, ok)
+	assert.Equal(t, RPCErrorInvalidRequest, errRes.Error.Code)
+}
@@ -25,7 +25,7 @@ func setupBlockchain(m *testing.M) {
 	tBC, _ = blockchain.LoadOrNewBlockchain(tDB, tGenesis, nil)
 	tEventBus = events.NewEventBus()
 	tChecker = execution.NewBatchChecker(tBC)
-	tCommitter = execution.NewBatchCommitter(tBC, tEventBus)
+	tCommitter = execution.NewBatchCommitter(tBC, tEventBus, nil)
 	tState = tBC.State()
 
 	tEventBus.Start()
@@ -17,7 +17,10 @@ type Envelope struct {
 	Type        tx.Type            `json:"type"`
 	Tx          tx.Tx              `json:"tx"`
 	Signatories []crypto.Signatory `json:"signatories,omitempty"`
-	hash        []byte
+	// ValidUntilHeight is the last height which the transaction can be executed at. Zero means no expiry.
+	// It is signed, so an expired transaction can't be replayed.
+	ValidUntilHeight uint64 `json:"validUntilHeight,omitempty"`
+	hash             []byte
 }
 
 func Enclose(chainId string, tx tx.Tx) *Envelope {
@@ -39,6 +42,11 @@ func (env Envelope) signBytes() ([]byte, error) {
 	return bs, nil
 }
 
+// Expired returns true if the transaction can't be executed at this height
+func (env *Envelope) Expired(height uint64) bool {
+	return env.ValidUntilHeight != 0 && height > env.ValidUntilHeight
+}
+
 func (env *Envelope) Hash() []byte {
 	if env.hash != nil {
 		return env.hash
@@ -140,10 +148,11 @@ func (env *Envelope) GenerateReceipt() *Receipt {
 // Marshaling/Unmarshaling methods
 func (env *Envelope) UnmarshalJSON(data []byte) error {
 	type _envelope struct {
-		ChainID     string             `json:"chainId"`
-		Type        tx.Type            `json:"type"`
-		Tx          json.RawMessage    `json:"tx"`
-		Signatories []crypto.Signatory `json:"signatories,omitempty"`
+		ChainID          string             `json:"chainId"`
+		Type             tx.Type            `json:"type"`
+		Tx               json.RawMessage    `json:"tx"`
+		Signatories      []crypto.Signatory `json:"signatories,omitempty"`
+		ValidUntilHeight uint64             `json:"validUntilHeight,omitempty"`
 	}
 
 	w := new(_envelope)
@@ -154,6 +163,7 @@ func (env *Envelope) UnmarshalJSON(data []byte) error {
 	env.ChainID = w.ChainID
 	env.Type = w.Type
 	env.Signatories = w.Signatories
+	env.ValidUntilHeight = w.ValidUntilHeight
 	// Now we know the Type we can de-serialize tx
 	env.Tx = tx.New(w.Type)
 	return json.Unmarshal(w.Tx, env.Tx)
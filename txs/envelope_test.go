@@ -198,3 +198,45 @@ func TestSignature(t *testing.T) {
 	err = env6.Verify()
 	require.NoError(t, err)
 }
+
+func TestValidUntilHeight(t *testing.T) {
+	_, pv := crypto.GenerateKey(nil)
+	signer := crypto.NewAccountSigner(pv)
+	tx, err := tx.NewSendTx(signer.Address(), crypto.GlobalAddress, 1, 100, 200)
+	require.NoError(t, err)
+
+	// Without the expiry, the sign bytes are the same as before
+	env1 := Enclose("test-chain", tx)
+	sb, _ := env1.signBytes()
+	assert.NotContains(t, string(sb), "validUntilHeight")
+	assert.False(t, env1.Expired(1000000))
+
+	env2 := Enclose("test-chain", tx)
+	env2.ValidUntilHeight = 10
+	require.NoError(t, env2.Sign(signer))
+	require.NoError(t, env2.Verify())
+	sb, _ = env2.signBytes()
+	assert.Contains(t, string(sb), "validUntilHeight")
+
+	bs, err := env2.Encode()
+	require.NoError(t, err)
+	env3 := new(Envelope)
+	require.NoError(t, env3.Decode(bs))
+	assert.Equal(t, env2, env3)
+
+	bs, err = json.Marshal(env2)
+	require.NoError(t, err)
+	env4 := new(Envelope)
+	require.NoError(t, json.Unmarshal(bs, env4))
+	assert.Equal(t, env2, env4)
+	require.NoError(t, env4.Verify())
+
+	assert.False(t, env2.Expired(9))
+	assert.False(t, env2.Expired(10))
+	assert.True(t, env2.Expired(11))
+
+	// The expiry is signed, changing it should fail
+	env4.ValidUntilHeight = 11
+	require.Error(t, env4.Verify())
+	assert.NotEqual(t, env1.Hash(), env2.Hash())
+}
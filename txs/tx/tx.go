@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/gallactic/gallactic/crypto"
+	e "github.com/gallactic/gallactic/errors"
 	amino "github.com/tendermint/go-amino"
 )
 
@@ -100,6 +102,56 @@ func String(tx Tx) string {
 	return fmt.Sprintf("%s%s", tx.Type(), string(bytes))
 }
 
+// Addresses returns the addresses of the signers and the receivers of the transaction
+func Addresses(tx Tx) []crypto.Address {
+	var addrs []crypto.Address
+	for _, signer := range tx.Signers() {
+		addrs = append(addrs, signer.Address)
+	}
+
+	switch t := tx.(type) {
+	case *SendTx:
+		for _, receiver := range t.Receivers() {
+			addrs = append(addrs, receiver.Address)
+		}
+	case *CallTx:
+		if !t.CreateContract() {
+			addrs = append(addrs, t.Callee().Address)
+		}
+	case *BondTx:
+		addrs = append(addrs, t.To().Address)
+	case *UnbondTx:
+		addrs = append(addrs, t.To().Address)
+	case *PermissionsTx:
+		addrs = append(addrs, t.Modified().Address)
+	}
+	return addrs
+}
+
+// SetSequence sets the sequence of the signer of the transaction.
+// Transactions with more than one signer are not supported.
+func SetSequence(tx Tx, seq uint64) error {
+	if len(tx.Signers()) != 1 {
+		return e.Errorf(e.ErrInvalidTxType, "transaction should have exactly one signer")
+	}
+
+	switch t := tx.(type) {
+	case *SendTx:
+		t.data.Senders[0].Sequence = seq
+	case *CallTx:
+		t.data.Caller.Sequence = seq
+	case *BondTx:
+		t.data.From.Sequence = seq
+	case *UnbondTx:
+		t.data.From.Sequence = seq
+	case *PermissionsTx:
+		t.data.Modifier.Sequence = seq
+	default:
+		return e.Errorf(e.ErrInvalidTxType, "unable to set the sequence of %v", tx.Type())
+	}
+	return nil
+}
+
 func New(txType Type) Tx {
 	switch txType {
 	case TypeSend: